@@ -4,14 +4,25 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
-	"github.com/mdhender/chief/internal/turnrpt"
+	"github.com/mdhender/chief/internal/adapters"
+	"github.com/mdhender/chief/internal/coords"
+	"github.com/mdhender/chief/internal/docconv"
+	"github.com/mdhender/chief/internal/model"
+	parser "github.com/mdhender/chief/internal/parsers/pigeon/turnrpt"
 	"github.com/mdhender/chief/internal/way"
+	"log"
 	"net/http"
+	"os"
 	"path/filepath"
 )
 
 func (s *Server) handleTurnReport() http.HandlerFunc {
+	type response struct {
+		Report      *model.Report        `json:"report,omitempty"`
+		Diagnostics []*parser.Diagnostic `json:"diagnostics,omitempty"`
+	}
 	return func(w http.ResponseWriter, r *http.Request) {
 		game, ok := s.games[way.Param(r.Context(), "game")]
 		if !ok {
@@ -26,6 +37,47 @@ func (s *Server) handleTurnReport() http.HandlerFunc {
 		year := way.Param(r.Context(), "year")
 		month := way.Param(r.Context(), "month")
 		turnReportFile := filepath.Join(clan.Docs, fmt.Sprintf("%s.%s-%s.Turn-Report.docx", clan.Id, year, month))
-		turnrpt.ParseToResponse(turnReportFile, w)
+
+		fp, err := os.Open(turnReportFile)
+		if err != nil {
+			log.Printf("[turn-report] open %v\n", err)
+			http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+			return
+		}
+		defer fp.Close()
+		doc, err := docconv.ReadDocx(fp)
+		if err != nil {
+			log.Printf("[turn-report] convert %v\n", err)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		// without a grid, the hidden "##" grids are kept for the grids solver
+		grid := clan.Grid
+		if grid == "" {
+			grid = "##"
+		} else if _, err := coords.Parse(grid + " 0101"); err != nil {
+			log.Printf("[turn-report] clan %s: grid %q: want AA..ZZ or ##\n", clan.Id, grid)
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		input := parser.NormalizeDocument(doc, grid)
+		raw, err := parser.Parse(turnReportFile, input)
+		rpt, _ := raw.(*parser.Report)
+
+		var resp response
+		resp.Diagnostics = parser.Diagnose(turnReportFile, input, rpt, err)
+		if rpt != nil {
+			rpt.FileName, rpt.Clan, rpt.Turn = turnReportFile, clan.Id, year+"-"+month
+			if resp.Report, err = adapters.PigeonReportToModel(rpt); err != nil {
+				log.Printf("[turn-report] model %v\n", err)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
 	}
 }
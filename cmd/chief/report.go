@@ -5,6 +5,7 @@ package main
 
 import (
 	"fmt"
+	"github.com/mdhender/chief/internal/adapters"
 	"github.com/mdhender/chief/internal/docconv"
 	"github.com/mdhender/chief/internal/lexer"
 	parser "github.com/mdhender/chief/internal/parsers/turnrpt"
@@ -14,6 +15,11 @@ import (
 	"os"
 )
 
+// argsReport holds the flags for the report command.
+var argsReport struct {
+	clan string // clan that owns the turn reports
+}
+
 // reportCmd implements the report command.
 var reportCmd = &cobra.Command{
 	Use:   "report",
@@ -27,7 +33,7 @@ var reportCmd = &cobra.Command{
 			if turn != "899-12" {
 				continue
 			}
-			filename := fmt.Sprintf("0138.%s.Turn-Report.docx", turn)
+			filename := fmt.Sprintf("%s.%s.Turn-Report.docx", argsReport.clan, turn)
 			log.Printf("[report] filename %s\n", filename)
 
 			if doLexer {
@@ -50,18 +56,21 @@ var reportCmd = &cobra.Command{
 				if err != nil {
 					log.Printf("[parser] %v\n", err)
 				} else if rpt != nil {
-					//log.Printf("[parser] report %s\n", rpt)
+					if m, err := adapters.ParsedReportToModel(rpt); err != nil {
+						log.Printf("[parser] %v\n", err)
+					} else {
+						log.Printf("[parser] units %d\n", len(m.Units))
+					}
 				}
 			}
 			if doScanner {
 				sections, err := turnrpt.ParseDocument(filename)
 				if err != nil {
 					log.Println(err)
+				} else if m, err := adapters.TribeSectionsToModel(argsReport.clan, sections); err != nil {
+					log.Println(err)
 				} else {
-					log.Printf("[scanner] sections %d\n", len(sections))
-					for _, section := range sections {
-						log.Printf("[scanner] section %+v\n", *section)
-					}
+					log.Printf("[scanner] units %d\n", len(m.Units))
 				}
 			}
 			log.Printf("[report] completed.\n\n\n")
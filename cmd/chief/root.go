@@ -18,6 +18,7 @@ var rootCmd = &cobra.Command{
 // Execute wires all the commands and sub-commands together.
 // It is called only by main().
 func Execute() {
+	reportCmd.Flags().StringVar(&argsReport.clan, "clan", "0138", "clan that owns the turn reports")
 	rootCmd.AddCommand(reportCmd)
 	rootCmd.AddCommand(serveCmd)
 
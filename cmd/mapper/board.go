@@ -1,13 +0,0 @@
-// chief - a TribeNet player aid
-// Copyright (c) 2023 Michael D Henderson. All rights reserved.
-
-package main
-
-import "github.com/mdhender/chief/internal/hexes"
-
-// constants for the TribeNet board.
-
-const (
-	// Layout is EvenQ, Flat-top
-	Layout = hexes.FLATHEX
-)
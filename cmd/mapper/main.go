@@ -6,127 +6,564 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"github.com/mdhender/chief/internal/coords"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/grids"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/stores/json/maps"
 	"github.com/mdhender/chief/internal/stores/json/scouting"
+	"github.com/mdhender/chief/internal/stores/wxx"
 	"github.com/mdhender/chief/internal/terrain"
 	"github.com/mdhender/chief/internal/tiles"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 )
 
 func main() {
 	root := "."
 	flag.StringVar(&root, "root", root, "path to data files")
+	clans := ""
+	flag.StringVar(&clans, "clans", clans, "comma separated list of clans to map (default is all clans)")
+	mapFile := "chief.map.json"
+	flag.StringVar(&mapFile, "map", mapFile, "map file to load and update")
+	anchors := ""
+	flag.StringVar(&anchors, "anchors", anchors, "comma separated list of known grids, like 900-01:0138=AB for the hex unit 0138 ended turn 900-01 in")
+	// use "DA" for "##" hexes that can't be resolved, unless the map has a grid
+	hashValue := "DA"
+	flag.StringVar(&hashValue, "grid", hashValue, "grid to use for \"##\" hexes that can't be resolved (AA..ZZ; an existing map keeps its grid)")
+	output := ""
+	flag.StringVar(&output, "output", output, "map image to create (default is chief.map with the format as the extension)")
+	format := ""
+	flag.StringVar(&format, "format", format, "format of the map image (svg, html, png or wxx; default is from the output extension, or svg)")
+	scale := 2.0
+	flag.Float64Var(&scale, "scale", scale, "pixels per unit for png images")
+	showTracks := false
+	flag.BoolVar(&showTracks, "tracks", showTracks, "draw the path of every unit and scout on the map")
+	trackUnits := ""
+	flag.StringVar(&trackUnits, "track-units", trackUnits, "comma separated list of units to draw paths for (default is all units)")
+	trackTurns := ""
+	flag.StringVar(&trackTurns, "track-turns", trackTurns, "comma separated list of turns to draw paths for (default is all turns)")
+	showGrids := false
+	flag.BoolVar(&showGrids, "grids", showGrids, "draw the border and name of every grid on the map")
+	sheet := ""
+	flag.StringVar(&sheet, "sheet", sheet, "draw only the grid (AA..ZZ), with its border and name")
+	overview := false
+	flag.BoolVar(&overview, "overview", overview, "draw a zoomed out map of every grid with its border and name")
+	shadeAge := false
+	flag.BoolVar(&shadeAge, "age", shadeAge, "shade hexes by the number of turns since they were last seen")
+	stale := 0
+	flag.IntVar(&stale, "stale", stale, "list the stalest hexes near the units (0 for none)")
+	staleRadius := 5
+	flag.IntVar(&staleRadius, "stale-radius", staleRadius, "how far from the units to look for stale hexes")
+	wxxImport := ""
+	flag.StringVar(&wxxImport, "import", wxxImport, "Worldographer (.wxx) file with hand drawn hexes to add to the map")
+	wxxOrigin := "AA"
+	flag.StringVar(&wxxOrigin, "import-origin", wxxOrigin, "grid of the top left hex in the imported file")
+	settlementsFile := "chief.settlements.json"
+	flag.StringVar(&settlementsFile, "settlements", settlementsFile, "file to save the settlements seen in the reports (empty for none)")
+	findingsFile := "chief.findings.json"
+	flag.StringVar(&findingsFile, "findings", findingsFile, "file to save the problems found in the reports and the map (empty for none)")
+
+	// Set custom usage function
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s:\n", os.Args[0])
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "usage: mapper [options] list of turns to map\n")
+		flag.PrintDefaults()
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "notes: if no turns are given, the root path will be scanned for turn folders.\n")
+	}
+
 	flag.Parse()
+	if format == "" {
+		if format = strings.TrimPrefix(filepath.Ext(output), "."); format == "" {
+			format = "svg"
+		}
+	}
+	if format != "svg" && format != "html" && format != "png" && format != "wxx" {
+		log.Fatalf("format: want svg, html, png or wxx: got %q\n", format)
+	} else if output == "" {
+		output = "chief.map." + format
+	}
+	if gh, err := coords.Parse(hashValue + " 0101"); err != nil || !gh.HasGrid() {
+		log.Fatalf("grid: want a grid from AA to ZZ: got %q\n", hashValue)
+	}
+	if sheet != "" && !regexp.MustCompile(`^[A-Z][A-Z]$`).MatchString(sheet) {
+		log.Fatalf("sheet: want a grid from AA to ZZ: got %q\n", sheet)
+	} else if sheet != "" && overview {
+		log.Fatalf("sheet: can't draw a sheet and the overview\n")
+	} else if format == "wxx" && (showGrids || sheet != "" || overview) {
+		log.Fatalf("format: wxx maps don't have grids, sheets or the overview\n")
+	}
 
-	clan := "0138"
-	var turns []*scouting.Results
-	for _, turn := range []string{"899-12", "900-01", "900-02", "900-03"} {
-		input := filepath.Join(root, turn, fmt.Sprintf("%s.%s.Scouting-Report.json", clan, turn))
-		j, err := scouting.ReadFile(input)
+	turns := flag.Args()
+	if len(turns) == 0 {
+		var err error
+		if turns, err = turnFolders(root); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	// load the map from earlier runs so that we keep those observations
+	m, err := maps.ReadFile(mapFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Printf("creating map %s\n", mapFile)
+		m = tiles.New(hashValue)
+	} else if err != nil {
+		log.Fatal(err)
+	} else if m.Hash() != hashValue {
+		// the "##" tiles already on the map are in the map's grid
+		gridSet := false
+		flag.Visit(func(f *flag.Flag) {
+			gridSet = gridSet || f.Name == "grid"
+		})
+		if gridSet {
+			log.Fatalf("grid: %s was created with grid %q, not %q\n", mapFile, m.Hash(), hashValue)
+		} else if gh, err := coords.Parse(m.Hash() + " 0101"); err != nil || !gh.HasGrid() {
+			log.Fatalf("grid: %s has grid %q: want a grid from AA to ZZ\n", mapFile, m.Hash())
+		}
+		hashValue = m.Hash()
+	}
+	if wxxImport != "" {
+		n, err := wxx.ReadFile(wxxImport, wxxOrigin, m)
 		if err != nil {
 			log.Fatal(err)
 		}
+		log.Printf("imported %d hexes from %s\n", n, wxxImport)
+	}
 
-		output := fmt.Sprintf("%s.%s.Scouting-Store.json", clan, turn)
-		if data, err := json.MarshalIndent(&j, "", "  "); err != nil {
-			log.Fatal(err)
-		} else if err = os.WriteFile(output, data, 0644); err != nil {
+	reports, err := scoutingReports(root, turns, clans)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var results []*scouting.Results
+	for _, input := range reports {
+		r, err := scouting.ReadFile(input)
+		if err != nil {
 			log.Fatal(err)
 		}
+		if r.Clan == "" {
+			r.Clan, _, _ = strings.Cut(filepath.Base(input), ".")
+		}
+		if r.Turn == "" {
+			r.Turn = filepath.Base(filepath.Dir(input))
+		}
+		results = append(results, r)
+	}
 
-		turns = append(turns, j)
+	// resolve the "##" grids from the reports and the anchors
+	solver, errs := grids.FromReports(results)
+	for _, err := range errs {
+		log.Printf("grids: %v\n", err)
+	}
+	for _, anchor := range strings.Split(anchors, ",") {
+		if anchor = strings.TrimSpace(anchor); anchor == "" {
+			continue
+		}
+		turn, rest, ok := strings.Cut(anchor, ":")
+		unit, grid, ok2 := strings.Cut(rest, "=")
+		if !ok || !ok2 {
+			log.Fatalf("anchors: want turn:unit=grid, got %q\n", anchor)
+		} else if err := solver.Anchor(grids.Key(turn, unit, true), grid); err != nil {
+			log.Fatalf("anchors: %v\n", err)
+		}
 	}
 
-	// assume that "##" is actually "DA"
-	hashValue := "DA"
-	maps := tiles.New(hashValue)
-	for _, turn := range turns {
-		for _, unit := range turn.Units {
-			if strings.HasPrefix(unit.Location.StartedIn, "##") {
-				unit.Location.StartedIn = hashValue + unit.Location.StartedIn[2:]
+	var tracks []*tiles.Track
+	var findings []*tiles.Finding
+	for _, r := range results {
+		log.Printf("mapping %s\n", r.FileName)
+		rt, rf := observeReport(m, r, solver, hashValue)
+		tracks, findings = append(tracks, rt...), append(findings, rf...)
+	}
+
+	unresolved := 0
+	for _, t := range m.Tiles() {
+		if t.Unresolved() {
+			unresolved++
+		}
+	}
+	if unresolved != 0 {
+		log.Printf("%d tiles are in the %q grid because their grid couldn't be resolved\n", unresolved, hashValue)
+	}
+
+	// check the merged map now that every report has been added
+	findings = append(findings, m.Check()...)
+	findings = append(findings, m.CheckTracks(tracks)...)
+	tiles.SortFindings(findings)
+	if err := writeFindings(findingsFile, findings); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeSettlements(settlementsFile, m.Settlements()); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := maps.WriteFile(mapFile, m); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("saved %s\n", mapFile)
+
+	// the current turn is the last one mapped
+	current := ""
+	for _, r := range results {
+		current = max(current, r.Turn)
+	}
+	if stale > 0 && current != "" {
+		// the units are wherever they ended the current turn
+		units := map[string]string{}
+		for _, t := range tracks {
+			if t.Turn == current && t.Scout == "" && len(t.Hexes) != 0 {
+				units[t.Unit] = t.Hexes[len(t.Hexes)-1]
 			}
-			if strings.HasPrefix(unit.Location.Current, "##") {
-				unit.Location.Current = hashValue + unit.Location.Current[2:]
+		}
+		for _, st := range m.Stalest(current, units, staleRadius, stale) {
+			if st.Age < 0 {
+				log.Printf("stale: %s never seen, %d hexes from %s\n", st.Id, st.Distance, st.Unit)
+			} else {
+				log.Printf("stale: %s last seen %s (%d turns ago), %d hexes from %s\n", st.Id, st.LastSeen, st.Age, st.Distance, st.Unit)
 			}
-			if unit.Check != nil && strings.HasPrefix(unit.Check.Hex, "##") {
-				unit.Check.Hex = hashValue + unit.Check.Hex[2:]
+		}
+	}
+
+	if format == "wxx" {
+		origin, err := wxx.WriteFile(output, m)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("created %s (origin grid %s)\n", output, origin)
+		return
+	}
+
+	s := tiles.NewSVG(true)
+	if overview {
+		s = tiles.NewOverview()
+	}
+	for _, tile := range m.Tiles() {
+		if sheet == "" || strings.HasPrefix(tile.Id(), sheet) {
+			s.AddTile(tile)
+		}
+	}
+	if showGrids || sheet != "" || overview {
+		s.ShowGrids()
+	}
+	if shadeAge && current != "" {
+		s.ShadeByAge(current)
+	}
+	if showTracks {
+		units, turns := csvSet(trackUnits), csvSet(trackTurns)
+		for _, t := range tracks {
+			if (len(units) == 0 || units[t.Unit]) && (len(turns) == 0 || turns[t.Turn]) && onSheet(t, sheet) {
+				s.AddTrack(t)
 			}
 		}
 	}
+	if err := writeMap(output, format, s, scale); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("created %s\n", output)
+}
 
-	// convert all successful moves into tiles
-	for _, turn := range turns {
-		for _, unit := range turn.Units {
-			starting := maps.MakeTile(unit.Location.StartedIn)
-			log.Printf("turn %s unit %s starting %s %s\n", turn.Turn, unit.Id, unit.Location.StartedIn, starting)
-			current := mapMovement(maps, unit.Movement, starting)
-			if unit.Scouts != nil {
-				for _, id := range []string{"1", "2", "3", "4", "5", "6", "7", "8"} {
-					scout := unit.Scouts[id]
-					if scout == nil {
-						continue
-					}
-					mapMovement(maps, scout.Scout, current)
-				}
+// onSheet returns true if every hex of the track is in the grid.
+// Every track is on the sheet when the grid is empty.
+func onSheet(t *tiles.Track, grid string) bool {
+	for _, gxy := range t.Hexes {
+		if grid != "" && !strings.HasPrefix(gxy, grid) {
+			return false
+		}
+	}
+	return true
+}
+
+// writeMap writes the map image in the format.
+func writeMap(name, format string, s *tiles.SVG, scale float64) error {
+	switch format {
+	case "html":
+		return os.WriteFile(name, s.HTML("TribeNet Map"), 0644)
+	case "png":
+		fp, err := os.Create(name)
+		if err != nil {
+			return err
+		}
+		if err := s.PNG(fp, scale); err != nil {
+			_ = fp.Close()
+			return err
+		}
+		return fp.Close()
+	}
+	return os.WriteFile(name, s.Bytes(), 0644)
+}
+
+// writeFindings saves the findings as JSON and logs how many of each kind
+// there are. Nothing is saved if the name is empty.
+func writeFindings(name string, findings []*tiles.Finding) error {
+	kinds := map[string]int{}
+	for _, f := range findings {
+		kinds[f.Kind]++
+	}
+	var list []string
+	for kind, n := range kinds {
+		list = append(list, fmt.Sprintf("%d %s", n, kind))
+	}
+	sort.Strings(list)
+	log.Printf("found %d problems %v\n", len(findings), list)
+	if name == "" {
+		return nil
+	}
+	if findings == nil {
+		findings = []*tiles.Finding{}
+	}
+	data, err := json.MarshalIndent(findings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("findings: %w", err)
+	} else if err = os.WriteFile(name, data, 0644); err != nil {
+		return fmt.Errorf("findings: %w", err)
+	}
+	log.Printf("saved %s\n", name)
+	return nil
+}
+
+// writeSettlements saves the settlement registry as JSON and logs
+// the settlements that were renamed. Nothing is saved if the name is empty.
+func writeSettlements(name string, settlements []*tiles.Settlement) error {
+	for _, st := range settlements {
+		if st.Renamed() {
+			log.Printf("settlements: %s renamed %s\n", st.Hex, strings.Join(st.Names, " -> "))
+		}
+	}
+	log.Printf("found %d settlements\n", len(settlements))
+	if name == "" {
+		return nil
+	}
+	if settlements == nil {
+		settlements = []*tiles.Settlement{}
+	}
+	data, err := json.MarshalIndent(settlements, "", "  ")
+	if err != nil {
+		return fmt.Errorf("settlements: %w", err)
+	} else if err = os.WriteFile(name, data, 0644); err != nil {
+		return fmt.Errorf("settlements: %w", err)
+	}
+	log.Printf("saved %s\n", name)
+	return nil
+}
+
+// observeReport adds everything the clan's units saw during the turn, and
+// the clan's settlements, to the map. It returns the paths of the units and
+// their scouts, along with the problems found in the report.
+// Hidden grids are resolved by the solver; if it can't, the hash grid is
+// used and the observations are flagged as unresolved.
+func observeReport(m *tiles.Map, r *scouting.Results, solver *grids.Solver, hashValue string) (tracks []*tiles.Track, findings []*tiles.Finding) {
+	resolve := func(unit string, end bool, h model.Hex) (model.Hex, bool) {
+		if rh, ok := solver.Hex(grids.Key(r.Turn, unit, end), h); ok {
+			return rh, true
+		}
+		return h.WithGrid(hashValue), false
+	}
+
+	var ids []string
+	for id := range r.Units {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		unit := r.Units[id]
+		if unit.Location == nil {
+			findings = append(findings, &tiles.Finding{Kind: tiles.BadReport, Turn: r.Turn, Unit: unit.Id, Text: "missing location"})
+			continue
+		}
+		starting, resolvedStart := resolve(unit.Id, false, unit.Location.StartedIn)
+		current, resolvedEnd := resolve(unit.Id, true, unit.Location.Current)
+		ending, track, finding := observeMoves(m, r, unit.Id, unit.Movement, starting, !resolvedStart)
+		tracks = append(tracks, track)
+		// a grid that wasn't resolved is a guess, so then only the column
+		// and row of the hexes can be compared
+		endsMatch := ending == current
+		if !resolvedStart || !resolvedEnd {
+			endsMatch = ending.Col == current.Col && ending.Row == current.Row
+		}
+		if finding != nil {
+			findings = append(findings, finding)
+		} else if unit.Follows == "" && !endsMatch {
+			findings = append(findings, &tiles.Finding{Kind: tiles.EndMismatch, Hex: ending.String(), Turn: r.Turn, Unit: unit.Id, Text: fmt.Sprintf("moves end in %s, but the report says %s", ending, current)})
+		}
+
+		var scouts []string
+		for id := range unit.Scouts {
+			scouts = append(scouts, id)
+		}
+		sort.Strings(scouts)
+		for _, id := range scouts {
+			_, track, finding := observeMoves(m, r, unit.Id, unit.Scouts[id].Scout, current, !resolvedEnd)
+			track.Scout = id
+			tracks = append(tracks, track)
+			if finding != nil {
+				finding.Unit = track.Name()
+				findings = append(findings, finding)
 			}
-			ending := maps.MakeTile(unit.Location.Current)
-			log.Printf("turn %s unit %s ending   %s %s\n", turn.Turn, unit.Id, unit.Location.Current, ending)
-			if unit.Check != nil {
-				if len(unit.Check.Hex) != 7 {
-					panic(fmt.Sprintf("turn %q unit %q hex %q", turn.Turn, unit.Id, unit.Check.Hex))
-				}
-				check := maps.MakeTile(unit.Check.Hex)
-				log.Printf("turn %s unit %s check    %s %s\n", turn.Turn, unit.Id, unit.Check.Hex, check)
-				if current.Id() != ending.Id() {
-					log.Printf("turn %q unit %q: current: id != ending (%q, %q)\n", turn.Turn, unit.Id, current.Id(), ending.Id())
-				}
-				if ending.Id() != check.Id() {
-					log.Printf("turn %q unit %q ending.id != check (%q, %q)\n", turn.Turn, unit.Id, ending.Id(), check.Id())
-				} else {
-					if check.Terrain != terrain.Unknown && ending.Terrain != check.Terrain {
-						log.Printf("turn %q unit %q ending.terrain != check (%q, %q)\n", turn.Turn, unit.Id, ending.Terrain, check.Terrain)
-					}
-				}
+		}
+
+		if unit.Check != nil && unit.Check.Hex.IsZero() {
+			findings = append(findings, &tiles.Finding{Kind: tiles.BadReport, Turn: r.Turn, Unit: unit.Id, Text: "status line has no hex"})
+		} else if unit.Check != nil {
+			hex, resolved := resolve(unit.Id, true, unit.Check.Hex)
+			check := m.Observe(hex.String(), &tiles.Observation{
+				Turn:       r.Turn,
+				Clan:       r.Clan,
+				Unit:       unit.Id,
+				Terrain:    unit.Check.Terrain,
+				Edges:      observedEdges(unit.Check.Edges),
+				Found:      unit.Check.Found,
+				Unresolved: !resolved,
+			})
+			if current.String() != check.Id() {
+				findings = append(findings, &tiles.Finding{Kind: tiles.StatusMismatch, Hex: check.Id(), Turn: r.Turn, Unit: unit.Id, Text: fmt.Sprintf("status line is for %s, but the report says the unit ended in %s", check.Id(), current)})
+			} else if t := lastTerrain(unit.Movement); unit.Follows == "" && endsMatch && t != terrain.Unknown && unit.Check.Terrain != terrain.Unknown && unit.Check.Terrain != t {
+				findings = append(findings, &tiles.Finding{Kind: tiles.StatusMismatch, Hex: check.Id(), Turn: r.Turn, Unit: unit.Id, Text: fmt.Sprintf("status line terrain is %s, but the last move entered %s", unit.Check.Terrain, t)})
 			}
 		}
 	}
 
-	s := tiles.NewSVG(true)
-	for _, tile := range maps.Tiles() {
-		log.Printf("dump tile %s %s\n", tile.Id(), tile.Terrain.String())
-		s.AddTile(tile)
+	// the settlements are placed with the grid of the unit that reported
+	// them, from the start or the end of the turn
+	for _, st := range r.Settlements {
+		if st.Hex.IsZero() || st.Name == "" {
+			findings = append(findings, &tiles.Finding{Kind: tiles.BadReport, Turn: r.Turn, Unit: st.Unit, Text: fmt.Sprintf("settlement %q has no hex or name", st.Name)})
+			continue
+		}
+		hex, resolved := resolve(st.Unit, !st.Started, st.Hex)
+		m.Observe(hex.String(), &tiles.Observation{
+			Turn:         r.Turn,
+			Clan:         r.Clan,
+			Unit:         st.Unit,
+			UnitInferred: st.UnitInferred,
+			Settlements:  []string{st.Name},
+			Unresolved:   !resolved,
+		})
+	}
+	return tracks, findings
+}
+
+// lastTerrain returns the terrain entered by the last successful move,
+// or Unknown if there isn't one.
+func lastTerrain(moves []*scouting.Movement) terrain.Terrain {
+	for n := len(moves) - 1; n >= 0; n-- {
+		if result := moves[n].Result; result != nil && result.Failed == nil {
+			return result.Terrain
+		}
 	}
-	log.Printf("%s\n", string(s.Bytes()))
+	return terrain.Unknown
 }
 
-func mapMovement(maps *tiles.Map, moves []*scouting.Movement, current *tiles.Tile) *tiles.Tile {
+// observeMoves adds the hexes seen during the moves to the map and returns
+// the hex the moves ended in, along with the path of the unit. Failed moves
+// are observations of the hex the unit was in. It stops and returns the zero
+// Hex and a finding if a move can't be followed.
+func observeMoves(m *tiles.Map, r *scouting.Results, unit string, moves []*scouting.Movement, from model.Hex, unresolved bool) (model.Hex, *tiles.Track, *tiles.Finding) {
+	track := &tiles.Track{Turn: r.Turn, Unit: unit}
+	if !from.IsZero() {
+		track.Hexes = append(track.Hexes, from.String())
+	}
 	for n, move := range moves {
-		if move.Result.Failed != nil {
+		if from.IsZero() {
+			break
+		}
+		o := &tiles.Observation{Turn: r.Turn, Clan: r.Clan, Unit: unit, Unresolved: unresolved}
+		if move.Result != nil {
+			o.Edges = observedEdges(move.Result.Edges)
+			o.Found = move.Result.Found
+		}
+		if move.Result != nil && move.Result.Failed != nil {
+			m.Observe(from.String(), o)
+			if d, ok := tiles.LookupDirection(move.Direction); ok {
+				track.Stops = append(track.Stops, tiles.Stop{Hex: len(track.Hexes) - 1, Direction: d})
+			}
 			continue
 		}
-		id := current.Id()
-		switch move.Direction {
-		case "N":
-			current = maps.Neighbor(current, tiles.N)
-		case "NE":
-			current = maps.Neighbor(current, tiles.NE)
-		case "SE":
-			current = maps.Neighbor(current, tiles.SE)
-		case "S":
-			current = maps.Neighbor(current, tiles.S)
-		case "SW":
-			current = maps.Neighbor(current, tiles.SW)
-		case "NW":
-			current = maps.Neighbor(current, tiles.NW)
-		default:
-			panic(fmt.Sprintf("assert(direction != %q)", move.Direction))
-		}
-		current.Terrain = move.Result.Terrain
-		log.Printf("move %d from %s %-2s to %s (%s)\n", n+1, id, move.Direction, current.Id(), current.Terrain.String())
-	}
-	return current
+		to, ok := from.Neighbor(move.Direction)
+		if !ok {
+			return model.Hex{}, track, &tiles.Finding{Kind: tiles.BadReport, Hex: from.String(), Turn: r.Turn, Unit: unit, Text: fmt.Sprintf("move %d: can't move %q", n+1, move.Direction)}
+		}
+		if move.Result != nil {
+			o.Terrain = move.Result.Terrain
+		}
+		m.Observe(to.String(), o)
+		track.Hexes = append(track.Hexes, to.String())
+		from = to
+	}
+	return from, track, nil
+}
+
+// observedEdges returns the edges that were seen, by direction.
+func observedEdges(edges map[string]*edge.Edge) map[string]edge.Edge {
+	var seen map[string]edge.Edge
+	for d, e := range edges {
+		if e == nil || *e == edge.Unknown {
+			continue
+		} else if seen == nil {
+			seen = make(map[string]edge.Edge)
+		}
+		seen[d] = *e
+	}
+	return seen
+}
+
+// csvSet returns the values in a comma separated list.
+func csvSet(list string) map[string]bool {
+	set := map[string]bool{}
+	for _, v := range strings.Split(list, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			set[v] = true
+		}
+	}
+	return set
+}
+
+// scoutingReports returns the paths to the scouting reports for the turns,
+// sorted by turn and clan. If clans is not empty, it is a comma separated
+// list of the clans to include.
+func scoutingReports(root string, turns []string, clans string) ([]string, error) {
+	want := csvSet(clans)
+
+	sort.Strings(turns)
+	var reports []string
+	for _, turn := range turns {
+		files, err := filepath.Glob(filepath.Join(root, turn, fmt.Sprintf("*.%s.Scouting-Report.json", turn)))
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			clan, _, _ := strings.Cut(filepath.Base(file), ".")
+			if len(want) == 0 || want[clan] {
+				reports = append(reports, file)
+			}
+		}
+	}
+	return reports, nil
+}
+
+// turnFolders returns the names of the folders in the path that
+// look like turns, which are a three-digit year, a dash, and a
+// two-digit month ("YYY-MM").
+func turnFolders(path string) (turns []string, err error) {
+	reTurn := regexp.MustCompile(`^\d{3}-\d{2}$`)
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && reTurn.MatchString(entry.Name()) {
+			turns = append(turns, entry.Name())
+		}
+	}
+	return turns, nil
 }
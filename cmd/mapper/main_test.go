@@ -0,0 +1,58 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"github.com/mdhender/chief/internal/grids"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/stores/json/scouting"
+	"github.com/mdhender/chief/internal/terrain"
+	"github.com/mdhender/chief/internal/tiles"
+	"testing"
+)
+
+func TestObserveReportEndMismatch(t *testing.T) {
+	hex := func(s string) model.Hex {
+		h, err := model.ParseHex(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	for _, tc := range []struct {
+		id       int
+		start    string
+		current  string
+		anchor   string // grid of the end of the turn, if known
+		mismatch bool
+	}{
+		// the start falls back to the hash grid, and the move crosses into the next grid
+		{1, "## 3010", "## 0111", "AB", false},
+		{2, "## 3010", "## 0111", "", false},
+		{3, "AA 3010", "AB 0111", "", false},
+		// the column and row are still checked
+		{4, "## 3010", "## 0112", "AB", true},
+		{5, "AA 3010", "AC 0111", "", true},
+	} {
+		r := &scouting.Results{Turn: "900-01", Clan: "0138", Units: map[string]*model.Unit{
+			"0138": {Id: "0138", Location: &model.UnitLocation{StartedIn: hex(tc.start), Current: hex(tc.current)}, Movement: []*model.Movement{
+				{Direction: "SE", Result: &model.MovementResult{Terrain: terrain.PR}},
+			}},
+		}}
+		solver := grids.New()
+		if tc.anchor != "" {
+			if err := solver.Anchor(grids.Key("900-01", "0138", true), tc.anchor); err != nil {
+				t.Fatal(err)
+			}
+		}
+		_, findings := observeReport(tiles.New("DA"), r, solver, "DA")
+		mismatch := false
+		for _, f := range findings {
+			mismatch = mismatch || f.Kind == tiles.EndMismatch
+		}
+		if mismatch != tc.mismatch {
+			t.Errorf("%d: mismatch: expected %v: got %v %v\n", tc.id, tc.mismatch, mismatch, findings)
+		}
+	}
+}
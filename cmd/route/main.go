@@ -0,0 +1,74 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+// Package main implements a route planner for TribeNet.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/mdhender/chief/internal/stores/json/maps"
+	"github.com/mdhender/chief/internal/terrain"
+	"github.com/mdhender/chief/internal/tiles"
+	"log"
+	"os"
+	"strings"
+)
+
+func main() {
+	mapFile := "chief.map.json"
+	flag.StringVar(&mapFile, "map", mapFile, "map file created by the mapper")
+	from := ""
+	flag.StringVar(&from, "from", from, "starting hex, like \"AB 0102\"")
+	to := ""
+	flag.StringVar(&to, "to", to, "destination hex, like \"AB 0509\"")
+	rulesFile := ""
+	flag.StringVar(&rulesFile, "rules", rulesFile, "JSON file with movement rules (default is the built-in rules)")
+	season := ""
+	flag.StringVar(&season, "season", season, "season from the turn report, like Winter")
+	transport := "foot"
+	flag.StringVar(&transport, "transport", transport, "means of transport (foot, horse, elephant or camel)")
+	unknown := false
+	flag.BoolVar(&unknown, "unknown", unknown, "allow moving through hexes that haven't been seen")
+	unknownCost := 0
+	flag.IntVar(&unknownCost, "unknown-cost", unknownCost, "movement points to enter a hex that hasn't been seen (default is the highest terrain cost)")
+	flag.Parse()
+
+	if from == "" || to == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	tr, ok := terrain.LookupTransport(transport)
+	if !ok {
+		log.Fatalf("transport: unknown %q\n", transport)
+	}
+	rules := terrain.DefaultRules()
+	if rulesFile != "" {
+		var err error
+		if rules, err = terrain.ReadRules(rulesFile); err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		log.Printf("rules: using placeholder movement costs, not the rule book; see -rules\n")
+	}
+
+	m, err := maps.ReadFile(mapFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	p, err := m.FindPath(strings.ToUpper(from), strings.ToUpper(to), tiles.PathOptions{
+		Costs:       rules.Table(season, tr),
+		Crossings:   rules.Crossings,
+		Unknown:     unknown,
+		UnknownCost: unknownCost,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%s to %s: %d moves, %d movement points (%d per turn)\n", from, to, len(p.Directions), p.Cost, rules.Allowance[tr])
+	log.Printf("route: %s\n", strings.Join(p.Hexes, ", "))
+
+	// the directions go to stdout so they can be copied into the orders
+	fmt.Println(p.Orders())
+}
@@ -8,7 +8,10 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"github.com/mdhender/chief/internal/adapters"
+	"github.com/mdhender/chief/internal/docconv"
 	parser "github.com/mdhender/chief/internal/parsers/pigeon/turnrpt"
+	"github.com/mdhender/chief/internal/stores/json/scouting"
 	"log"
 	"os"
 	"path/filepath"
@@ -18,10 +21,16 @@ import (
 func main() {
 	clan := "0138"
 	flag.StringVar(&clan, "clan", clan, "clan id")
-	grid := "AA"
-	flag.StringVar(&grid, "grid", grid, "location of grid (AA..ZZ)")
+	grid := "##"
+	flag.StringVar(&grid, "grid", grid, "location of grid (AA..ZZ), or ## to let the mapper resolve it")
 	root := "."
 	flag.StringVar(&root, "root", root, "path to data files")
+	format := "text"
+	flag.StringVar(&format, "format", format, "format for diagnostics (text or json)")
+	saveText := false
+	flag.BoolVar(&saveText, "text", saveText, "save the normalized text of the report")
+	saveBoard := false
+	flag.BoolVar(&saveBoard, "board", saveBoard, "save a map of the terrain scouted this turn")
 
 	// Set custom usage function
 	flag.Usage = func() {
@@ -32,6 +41,12 @@ func main() {
 	}
 
 	flag.Parse()
+	if format != "text" && format != "json" {
+		log.Fatalf("format: want text or json: got %q\n", format)
+	}
+	if !regexp.MustCompile(`^([A-Z][A-Z]|##)$`).MatchString(grid) {
+		log.Fatalf("grid: want AA..ZZ or ##: got %q\n", grid)
+	}
 
 	// turns defaults to the remaining command line arguments.
 	// If there are none, then use use the `turnFolders()` function
@@ -45,50 +60,95 @@ func main() {
 	}
 
 	log.Printf("parsing %+v\n", turns)
+	var diags []*parser.Diagnostic
 	for _, turn := range turns {
-		err := parseReport(root, clan, turn, grid)
+		d, err := parseReport(root, clan, turn, grid, saveText, saveBoard)
 		if err != nil {
 			log.Fatal(err)
 		}
+		diags = append(diags, d...)
+	}
+
+	if format == "json" {
+		if diags == nil {
+			diags = []*parser.Diagnostic{}
+		}
+		data, err := json.MarshalIndent(diags, "", "\t")
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(string(data))
+	} else {
+		for _, d := range diags {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", d.Error())
+		}
+	}
+	if len(diags) != 0 {
+		log.Fatalf("found %d problems in the turn reports\n", len(diags))
 	}
 
 	log.Printf("all turn reports parsed\n")
 }
 
-func parseReport(root, clan, turn, grid string) error {
-	filename := filepath.Join(root, turn, fmt.Sprintf("%s.%s.Turn-Report.txt", clan, turn))
-	if sb, err := os.Stat(filename); err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("turn report file does not exist: %s", filename)
-		}
-		return err
-	} else if sb.IsDir() {
-		return fmt.Errorf("turn report is not a file: %s", filename)
+// parseReport parses the turn report and writes the results as JSON.
+// It returns the diagnostics for any problems found in the report.
+func parseReport(root, clan, turn, grid string, saveText, saveBoard bool) ([]*parser.Diagnostic, error) {
+	filename, err := reportFile(root, clan, turn)
+	if err != nil {
+		return nil, err
 	}
 	log.Printf("parsing %s\n", filename)
 
-	input, err := os.ReadFile(filename)
+	// read the report and apply filters to the input
+	input, err := readReport(filename, grid)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// apply filters to the input
-	if len(grid) == 2 {
-		input = parser.FilterDefaultGrid(input, grid)
-	} else {
-		input = parser.FilterDefaultGrid(input, "LL")
+	if saveText {
+		textFile := filepath.Join(root, turn, fmt.Sprintf("%s.%s.Turn-Report.normalized.txt", clan, turn))
+		if err := os.WriteFile(textFile, input, 0644); err != nil {
+			return nil, err
+		}
+		log.Printf("created %s\n", textFile)
 	}
-	input = parser.TransformMarkScoutLines(input)
 
 	// parse the turn report
 	raw, err := parser.Parse(filename, input)
-	if err != nil {
-		return err
+	rpt, ok := raw.(*parser.Report)
+	diags := parser.Diagnose(filename, input, rpt, err)
+	if !ok {
+		return diags, nil
 	}
-	rpt := raw.(*parser.Report)
 	rpt.FileName = filename
 	rpt.Clan = clan
 	rpt.Turn = turn
+
+	// convert the report to scouting results for the mapper
+	results, err := adapters.PigeonReportToModel(rpt)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	results.Turn = turn
+	resultsFile := filepath.Join(root, turn, fmt.Sprintf("%s.%s.Scouting-Report.json", clan, turn))
+	if err = scouting.WriteFile(resultsFile, results); err != nil {
+		return nil, err
+	}
+	log.Printf("created %s\n", resultsFile)
+
+	if saveBoard {
+		// the board needs a grid, so there is no board for hidden grids
+		if b, err := adapters.ScoutingResultsToBoard(results); err != nil {
+			log.Printf("%s: board: %v\n", filename, err)
+		} else {
+			boardFile := filepath.Join(root, turn, fmt.Sprintf("%s.%s.Scouting-Board.svg", clan, turn))
+			if err := os.WriteFile(boardFile, b.AsSVG(true), 0644); err != nil {
+				return nil, err
+			}
+			log.Printf("created %s\n", boardFile)
+		}
+	}
+
 	if len(rpt.Rest) > 35 {
 		rpt.Rest = rpt.Rest[:35]
 	}
@@ -104,15 +164,56 @@ func parseReport(root, clan, turn, grid string) error {
 
 	data, err := json.MarshalIndent(rpt, "", "\t")
 	if err != nil {
-		return err
+		return nil, err
 	}
 	filename = filepath.Join(root, turn, fmt.Sprintf("%s.%s.Turn-Report.json", clan, turn))
 	if err = os.WriteFile(filename, data, 0644); err != nil {
-		return err
+		return nil, err
 	}
 	log.Printf("created %s\n", filename)
 
-	return nil
+	return diags, nil
+}
+
+// reportFile returns the path to the turn report for the clan and turn.
+// It prefers the .docx file from the GM and falls back to a .txt file.
+func reportFile(root, clan, turn string) (string, error) {
+	for _, ext := range []string{"docx", "txt"} {
+		filename := filepath.Join(root, turn, fmt.Sprintf("%s.%s.Turn-Report.%s", clan, turn, ext))
+		if sb, err := os.Stat(filename); err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", err
+		} else if sb.IsDir() {
+			return "", fmt.Errorf("turn report is not a file: %s", filename)
+		}
+		return filename, nil
+	}
+	return "", fmt.Errorf("turn report file does not exist: %s", filepath.Join(root, turn, fmt.Sprintf("%s.%s.Turn-Report.docx", clan, turn)))
+}
+
+// readReport returns the normalized text of the turn report.
+// The sections of .docx files are found from their paragraphs;
+// other files are read as text.
+func readReport(filename, grid string) ([]byte, error) {
+	if filepath.Ext(filename) != ".docx" {
+		input, err := os.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		return parser.NormalizeReport(input, grid), nil
+	}
+	fp, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer fp.Close()
+	doc, err := docconv.ReadDocx(fp)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return parser.NormalizeDocument(doc, grid), nil
 }
 
 // turnFolders reads the directory specified by the path and returns
@@ -8,6 +8,9 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"github.com/mdhender/chief/internal/stores/json/maps"
+	"github.com/mdhender/chief/internal/stores/json/scouting"
+	"github.com/mdhender/chief/internal/terrain"
 	"github.com/xuri/excelize/v2"
 	"log"
 	"os"
@@ -29,6 +32,22 @@ func main() {
 	flag.StringVar(&filename, "input", filename, "xlsx file to load")
 	var turn string
 	flag.StringVar(&turn, "turn", turn, "turn to load")
+	var doSimulate bool
+	flag.BoolVar(&doSimulate, "simulate", doSimulate, "predict where the movement and scouting orders will end up")
+	mapFile := "chief.map.json"
+	flag.StringVar(&mapFile, "map", mapFile, "map file created by the mapper, for simulating")
+	var reportFile string
+	flag.StringVar(&reportFile, "report", reportFile, "scouting report with the units' current hexes (default is the previous turn's report)")
+	var rulesFile string
+	flag.StringVar(&rulesFile, "rules", rulesFile, "JSON file with movement rules (default is the built-in rules)")
+	var season string
+	flag.StringVar(&season, "season", season, "season from the turn report, like Winter")
+	var grid string
+	flag.StringVar(&grid, "grid", grid, "grid to use for \"##\" hexes (AA..ZZ)")
+	var doReconcile bool
+	flag.BoolVar(&doReconcile, "reconcile", doReconcile, "compare the orders with the results in the turn report")
+	var resultsFile string
+	flag.StringVar(&resultsFile, "results", resultsFile, "scouting report with the results of the orders (default is the turn's report)")
 	flag.Parse()
 
 	if clan == "" {
@@ -39,30 +58,69 @@ func main() {
 		filename = ""
 	}
 
+	var sim *simulator
+	if doSimulate {
+		sim = &simulator{rules: terrain.DefaultRules(), season: season, grid: strings.ToUpper(grid)}
+		if rulesFile != "" {
+			var err error
+			if sim.rules, err = terrain.ReadRules(rulesFile); err != nil {
+				log.Fatal(err)
+			}
+		} else {
+			log.Printf("rules: using placeholder movement costs, not the rule book; see -rules\n")
+		}
+		var err error
+		if sim.m, err = maps.ReadFile(mapFile); err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	turns := []string{"899-12", "900-01", "900-02", "900-03", "900-04"}
 	if turn != "" {
 		turns = []string{turn}
 	}
+	opts := options{clan: clan, sim: sim, reportFile: reportFile, reconcile: doReconcile, resultsFile: resultsFile}
 	for _, turn := range turns {
+		opts.turn = turn
 		if doReceived {
-			if err := run(clan, turn, doReceived); err != nil {
+			opts.received = true
+			if err := run(opts); err != nil {
 				log.Printf("%s: %s: error %v\n", clan, turn, err)
 			}
 		}
 		if doIssued {
-			if err := run(clan, turn, !doIssued); err != nil {
+			opts.received = false
+			if err := run(opts); err != nil {
 				log.Printf("%s: %s: error %v\n", clan, turn, err)
 			}
 		}
 	}
 }
 
-func run(clan, turn string, received bool) error {
-	var filename, jsonFilename string
+// options are the settings for loading one workbook.
+type options struct {
+	clan     string
+	turn     string
+	received bool // load the orders as received instead of as issued
+	// sim is nil if the orders aren't simulated
+	sim *simulator
+	// reportFile is the scouting report for the simulator,
+	// defaulting to the previous turn's report
+	reportFile string
+	reconcile  bool
+	// resultsFile is the scouting report for the reconciliation,
+	// defaulting to the turn's report
+	resultsFile string
+}
+
+func run(opts options) error {
+	clan, turn := opts.clan, opts.turn
+	var filename, jsonFilename, reconcileFilename string
 	revision := 0 // updated only for orders-issued
-	if received {
+	if opts.received {
 		filename = fmt.Sprintf("%s.%s.Orders.xlsx", clan, turn)
 		jsonFilename = fmt.Sprintf("output/%s.%s.received.json", clan, turn)
+		reconcileFilename = fmt.Sprintf("output/%s.%s.received.reconcile.txt", clan, turn)
 	} else { // orders issued
 		maxRevision := -1
 		if fnams, err := filepath.Glob(fmt.Sprintf("%s.%s.Orders-Issued.v*.xlsx", clan, turn)); err == nil {
@@ -82,6 +140,7 @@ func run(clan, turn string, received bool) error {
 			filename = fmt.Sprintf("%s.%s.Orders-Issued.v%d.xlsx", clan, turn, revision)
 		}
 		jsonFilename = fmt.Sprintf("output/%s.%s.issued.json", clan, turn)
+		reconcileFilename = fmt.Sprintf("output/%s.%s.issued.reconcile.txt", clan, turn)
 	}
 
 	f, err := excelize.OpenFile(filename)
@@ -125,6 +184,42 @@ func run(clan, turn string, received bool) error {
 	}
 	log.Printf("%s: %s: created %s\n", clan, turn, jsonFilename)
 
+	if opts.sim != nil {
+		reportFile := opts.reportFile
+		if reportFile == "" {
+			reportFile = fmt.Sprintf("%s.%s.Scouting-Report.json", clan, previousTurn(turn))
+		}
+		rpt, err := scouting.ReadFile(reportFile)
+		if err != nil {
+			return fmt.Errorf("simulate: %w", err)
+		}
+		for _, p := range opts.sim.simulate(w, rpt) {
+			log.Printf("%s: %s: %-9s %s -> %s (%s, %d of %d MP)\n", clan, turn, p.name(), p.start, p.end, p.transport, p.used, p.allowance)
+			for _, warning := range p.warnings {
+				log.Printf("%s: %s: %-9s warning: %s\n", clan, turn, p.name(), warning)
+			}
+			for _, problem := range p.problems {
+				log.Printf("%s: %s: %-9s error: %s\n", clan, turn, p.name(), problem)
+			}
+		}
+	}
+
+	if opts.reconcile {
+		resultsFile := opts.resultsFile
+		if resultsFile == "" {
+			resultsFile = fmt.Sprintf("%s.%s.Scouting-Report.json", clan, turn)
+		}
+		rpt, err := scouting.ReadFile(resultsFile)
+		if err != nil {
+			return fmt.Errorf("reconcile: %w", err)
+		}
+		rc := reconcile(w, rpt)
+		if err := os.WriteFile(reconcileFilename, rc.Bytes(), 0644); err != nil {
+			return fmt.Errorf("reconcile: %w", err)
+		}
+		log.Printf("%s: %s: created %s: %d discrepancies\n", clan, turn, reconcileFilename, rc.discrepancies())
+	}
+
 	return nil
 }
 
@@ -0,0 +1,280 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/tiles"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// reconciliation compares the orders in the workbook with the results
+// in the turn report.
+type reconciliation struct {
+	orders  string // name of the workbook
+	results string // name of the turn report
+	units   []*unitResults
+	// transfers are the ordered transfers
+	transfers []*result
+}
+
+// unitResults is what happened to the orders for a unit or one of its scouts.
+type unitResults struct {
+	name    string
+	results []*result
+}
+
+// result is what happened to a single order.
+type result struct {
+	order  string // the order, like "NE" or "0138 -> 0138e1 Horse 10"
+	status string // executed, failed, not executed, not processed, not ordered
+	detail string
+	// discrepancy is set if the result should be raised with the GM
+	discrepancy bool
+}
+
+const (
+	executed     = "executed"
+	failed       = "failed"
+	notExecuted  = "not executed"
+	notProcessed = "not processed"
+	notOrdered   = "not ordered"
+)
+
+// reconcile matches the movement, scouting and transfer orders with the
+// results in the report.
+func reconcile(w *workbook, rpt *model.Report) *reconciliation {
+	rc := &reconciliation{orders: w.Name, results: rpt.FileName}
+
+	var ids []string
+	for id, unit := range w.Units {
+		if unit.Movement != nil || len(unit.Scouts) != 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		unit, reported := w.Units[id], rpt.Units[id]
+		if unit.Movement != nil {
+			ur := &unitResults{name: id}
+			if reported == nil {
+				ur.results = append(ur.results, &result{order: "movement", status: notProcessed, detail: "unit is not in the report", discrepancy: true})
+			} else if unit.Movement.Follow != "" {
+				r := &result{order: "follow " + unit.Movement.Follow, status: executed}
+				if reported.Follows != unit.Movement.Follow {
+					r.status, r.discrepancy = notProcessed, true
+					if r.detail = "report shows no follow"; reported.Follows != "" {
+						r.detail = "report shows follow " + reported.Follows
+					}
+				}
+				ur.results = append(ur.results, r)
+			} else {
+				ur.results = reconcileMoves(unit.Movement.Moves, reported.Movement)
+			}
+			rc.units = append(rc.units, ur)
+		}
+
+		for n, scout := range unit.Scouts {
+			if len(scout.Moves) == 0 {
+				continue
+			}
+			ur := &unitResults{name: fmt.Sprintf("%s s%d", id, n+1)}
+			var moves []*Move
+			for _, mv := range scout.Moves {
+				moves = append(moves, &Move{Direction: mv.Direction, ToLimit: mv.ToLimit})
+			}
+			if reported == nil || reported.Scouts[strconv.Itoa(n+1)] == nil {
+				ur.results = append(ur.results, &result{order: "scout", status: notProcessed, detail: "scout is not in the report", discrepancy: true})
+			} else {
+				ur.results = reconcileMoves(moves, reported.Scouts[strconv.Itoa(n+1)].Scout)
+			}
+			rc.units = append(rc.units, ur)
+		}
+	}
+
+	if w.Transfers != nil {
+		var ordered []*Transfer
+		ordered = append(ordered, w.Transfers.BeforeMovement...)
+		ordered = append(ordered, w.Transfers.AfterMovement...)
+		rc.transfers = reconcileTransfers(ordered, rpt.Transfers)
+	}
+
+	return rc
+}
+
+// reconcileMoves matches the ordered moves with the moves in the report.
+// The game stops a unit at the first move that fails, so the orders after
+// it are not executed; that is expected. An order with no matching move,
+// or a move that wasn't ordered, is a discrepancy.
+func reconcileMoves(orders []*Move, moves []*model.Movement) []*result {
+	var results []*result
+	i, stopped := 0, false
+	for _, o := range orders {
+		order := o.Direction
+		if o.Still {
+			order = "STILL"
+		}
+		dir := order
+		if o.ToLimit {
+			dir = strings.TrimSuffix(dir, "L")
+		}
+
+		if stopped {
+			results = append(results, &result{order: order, status: notExecuted, detail: "movement already ended"})
+			continue
+		} else if o.Still {
+			results = append(results, &result{order: order, status: executed})
+			stopped = true
+			continue
+		} else if _, ok := tiles.LookupDirection(dir); !ok {
+			// following the river or the ocean uses up the rest of the moves
+			if i == len(moves) {
+				results = append(results, &result{order: order, status: notProcessed, discrepancy: true})
+			}
+			for ; i < len(moves); i++ {
+				results = append(results, moveResult(order, moves[i]))
+			}
+			stopped = true
+			continue
+		}
+
+		n := 0
+		for i < len(moves) && moves[i].Direction == dir {
+			r := moveResult(order, moves[i])
+			results = append(results, r)
+			i, n = i+1, n+1
+			if r.status == failed {
+				stopped = true
+				break
+			} else if !o.ToLimit {
+				break
+			}
+		}
+		if n == 0 {
+			r := &result{order: order, status: notProcessed, discrepancy: true}
+			if i < len(moves) {
+				r.detail = fmt.Sprintf("report shows %s instead", moves[i].Direction)
+			}
+			results = append(results, r)
+		}
+	}
+	for ; i < len(moves); i++ {
+		r := moveResult("", moves[i])
+		r.status, r.discrepancy = notOrdered, true
+		results = append(results, r)
+	}
+	return results
+}
+
+// moveResult returns the result of a move in the report.
+func moveResult(order string, mv *model.Movement) *result {
+	r := &result{order: order, status: executed}
+	if order == "" || order != mv.Direction {
+		r.order = strings.TrimSpace(order + " " + mv.Direction)
+	}
+	if mv.Result == nil {
+		return r
+	} else if f := mv.Result.Failed; f != nil {
+		r.status = failed
+		switch {
+		case f.NoFord:
+			r.detail = "no ford on the river"
+		case f.OceanCoast:
+			r.detail = "can't move on the ocean"
+		case f.NotEnoughMp:
+			r.detail = "not enough movement points"
+		default:
+			r.detail = "no reason given"
+		}
+		return r
+	}
+	r.detail = strings.TrimSpace(fmt.Sprintf("%s %s", mv.Result.To, mv.Result.Terrain))
+	return r
+}
+
+// reconcileTransfers matches the ordered transfers with the transfers in
+// the report. Each reported transfer matches at most one order.
+func reconcileTransfers(ordered []*Transfer, reported []*model.Transfer) []*result {
+	item := func(s string) string {
+		return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), "s")
+	}
+	used := make([]bool, len(reported))
+	var results []*result
+	for _, t := range ordered {
+		r := &result{order: fmt.Sprintf("%s -> %s %s %d", t.From, t.To, t.Item, t.Quantity), status: notProcessed, detail: "not in the report", discrepancy: true}
+		for i, rt := range reported {
+			if used[i] || rt.From != t.From || rt.To != t.To || item(rt.Item) != item(t.Item) {
+				continue
+			}
+			used[i] = true
+			if r.status, r.detail, r.discrepancy = executed, "", false; rt.Quantity != t.Quantity {
+				r.detail, r.discrepancy = fmt.Sprintf("report shows %d", rt.Quantity), true
+			}
+			break
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+// discrepancies returns the number of results to raise with the GM.
+func (rc *reconciliation) discrepancies() int {
+	n := 0
+	for _, ur := range rc.units {
+		for _, r := range ur.results {
+			if r.discrepancy {
+				n++
+			}
+		}
+	}
+	for _, r := range rc.transfers {
+		if r.discrepancy {
+			n++
+		}
+	}
+	return n
+}
+
+// Bytes returns the reconciliation as a text report. The discrepancies
+// are listed first, followed by the result of every order.
+func (rc *reconciliation) Bytes() []byte {
+	buf := &bytes.Buffer{}
+	_, _ = fmt.Fprintf(buf, "Orders:  %s\nResults: %s\n", rc.orders, rc.results)
+
+	tw := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintf(tw, "\nDiscrepancies: %d\n", rc.discrepancies())
+	for _, ur := range rc.units {
+		for _, r := range ur.results {
+			if r.discrepancy {
+				_, _ = fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", ur.name, r.order, r.status, r.detail)
+			}
+		}
+	}
+	for _, r := range rc.transfers {
+		if r.discrepancy {
+			_, _ = fmt.Fprintf(tw, "  transfer\t%s\t%s\t%s\n", r.order, r.status, r.detail)
+		}
+	}
+
+	for _, ur := range rc.units {
+		_, _ = fmt.Fprintf(tw, "\n%s\n", ur.name)
+		for n, r := range ur.results {
+			_, _ = fmt.Fprintf(tw, "  %d\t%s\t%s\t%s\n", n+1, r.order, r.status, r.detail)
+		}
+	}
+	if len(rc.transfers) != 0 {
+		_, _ = fmt.Fprintf(tw, "\nTransfers\n")
+		for n, r := range rc.transfers {
+			_, _ = fmt.Fprintf(tw, "  %d\t%s\t%s\t%s\n", n+1, r.order, r.status, r.detail)
+		}
+	}
+	_ = tw.Flush()
+	return buf.Bytes()
+}
@@ -0,0 +1,140 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/terrain"
+	"strings"
+	"testing"
+)
+
+func TestReconcileMoves(t *testing.T) {
+	// orders are like "N", "NL" (to the limit), "FOR" or "STILL"
+	orders := func(list ...string) []*Move {
+		var moves []*Move
+		for _, o := range list {
+			switch {
+			case o == "STILL":
+				moves = append(moves, &Move{Still: true})
+			case len(o) <= 3 && strings.HasSuffix(o, "L") && o != "FOL" && o != "FRL":
+				moves = append(moves, &Move{Direction: o, ToLimit: true})
+			default:
+				moves = append(moves, &Move{Direction: o})
+			}
+		}
+		return moves
+	}
+	// moves are like "N" (into prairie) or "N!ford", "N!ocean", "N!mp" for failures
+	moves := func(list ...string) []*model.Movement {
+		var mvs []*model.Movement
+		for _, m := range list {
+			dir, why, _ := strings.Cut(m, "!")
+			mv := &model.Movement{Direction: dir, Result: &model.MovementResult{Terrain: terrain.PR}}
+			switch why {
+			case "ford":
+				mv.Result = &model.MovementResult{Failed: &model.MovementFailure{NoFord: true}}
+			case "ocean":
+				mv.Result = &model.MovementResult{Failed: &model.MovementFailure{OceanCoast: true}}
+			case "mp":
+				mv.Result = &model.MovementResult{Failed: &model.MovementFailure{NotEnoughMp: true}}
+			}
+			mvs = append(mvs, mv)
+		}
+		return mvs
+	}
+
+	for _, tc := range []struct {
+		id     int
+		orders []*Move
+		moves  []*model.Movement
+		expect []string // order, status, detail and discrepancy ("!") for each result
+	}{
+		{1, orders("SL"), moves("S", "S", "S!mp"), []string{
+			"SL S|executed|PR", "SL S|executed|PR", "SL S|failed|not enough movement points",
+		}},
+		{2, orders("NL", "SE"), moves("N", "N", "SE"), []string{
+			"NL N|executed|PR", "NL N|executed|PR", "SE|executed|PR",
+		}},
+		{3, orders("NL", "N"), moves("N", "N"), []string{
+			"NL N|executed|PR", "NL N|executed|PR", "N|not processed|!",
+		}},
+		{4, orders("S", "FOR", "N"), moves("S", "SE", "SE"), []string{
+			"S|executed|PR", "FOR SE|executed|PR", "FOR SE|executed|PR", "N|not executed|movement already ended",
+		}},
+		{5, orders("S", "FRL"), moves("S"), []string{
+			"S|executed|PR", "FRL|not processed|!",
+		}},
+		{6, orders("N", "NE", "S"), moves("N", "NE!ford"), []string{
+			"N|executed|PR", "NE|failed|no ford on the river", "S|not executed|movement already ended",
+		}},
+		{7, orders("N", "SE"), moves("N!ocean"), []string{
+			"N|failed|can't move on the ocean", "SE|not executed|movement already ended",
+		}},
+		{8, orders("N"), moves("N", "NE", "S"), []string{
+			"N|executed|PR", "NE|not ordered|PR!", "S|not ordered|PR!",
+		}},
+		{9, orders("N"), moves("S"), []string{
+			"N|not processed|report shows S instead!", "S|not ordered|PR!",
+		}},
+		{10, orders("STILL", "N"), moves(), []string{
+			"STILL|executed|", "N|not executed|movement already ended",
+		}},
+		{11, orders(), moves("N"), []string{
+			"N|not ordered|PR!",
+		}},
+	} {
+		var got []string
+		for _, r := range reconcileMoves(tc.orders, tc.moves) {
+			s := fmt.Sprintf("%s|%s|%s", r.order, r.status, r.detail)
+			if r.discrepancy {
+				s += "!"
+			}
+			got = append(got, s)
+		}
+		if strings.Join(got, "\n") != strings.Join(tc.expect, "\n") {
+			t.Errorf("%d: expected\n\t%s\ngot\n\t%s\n", tc.id, strings.Join(tc.expect, "\n\t"), strings.Join(got, "\n\t"))
+		}
+	}
+}
+
+func TestReconcileTransfers(t *testing.T) {
+	reported := []*model.Transfer{
+		{From: "0138", To: "0138e1", Item: "Horse", Quantity: 10},
+		{From: "0138", To: "0138e1", Item: "Horse", Quantity: 5},
+		{From: "0138", To: "0138c1", Item: "Provs", Quantity: 100},
+		{From: "0138e1", To: "0138", Item: "Goat", Quantity: 3},
+	}
+	ordered := []*Transfer{
+		// the item names don't have to match exactly
+		{From: "0138", To: "0138e1", Item: "horses", Quantity: 10},
+		// the same order twice matches two transfers
+		{From: "0138", To: "0138e1", Item: "Horse", Quantity: 5},
+		// the quantity is different
+		{From: "0138", To: "0138c1", Item: "Provs", Quantity: 50},
+		// the third transfer of horses isn't in the report
+		{From: "0138", To: "0138e1", Item: "Horse", Quantity: 1},
+		// the units are the wrong way around
+		{From: "0138", To: "0138e1", Item: "Goat", Quantity: 3},
+	}
+	expect := []string{
+		"0138 -> 0138e1 horses 10|executed|",
+		"0138 -> 0138e1 Horse 5|executed|",
+		"0138 -> 0138c1 Provs 50|executed|report shows 100!",
+		"0138 -> 0138e1 Horse 1|not processed|not in the report!",
+		"0138 -> 0138e1 Goat 3|not processed|not in the report!",
+	}
+	var got []string
+	for _, r := range reconcileTransfers(ordered, reported) {
+		s := fmt.Sprintf("%s|%s|%s", r.order, r.status, r.detail)
+		if r.discrepancy {
+			s += "!"
+		}
+		got = append(got, s)
+	}
+	if strings.Join(got, "\n") != strings.Join(expect, "\n") {
+		t.Errorf("expected\n\t%s\ngot\n\t%s\n", strings.Join(expect, "\n\t"), strings.Join(got, "\n\t"))
+	}
+}
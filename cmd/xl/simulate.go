@@ -0,0 +1,264 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/terrain"
+	"github.com/mdhender/chief/internal/tiles"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// simulator replays the movement orders against the known map to predict
+// where every unit and scout will end the turn.
+type simulator struct {
+	m      *tiles.Map
+	rules  *terrain.Rules
+	season string
+	grid   string // grid to use for "##" hexes
+}
+
+// prediction is where the simulator expects a unit or scout to end up.
+type prediction struct {
+	unit      string
+	scout     int // 1-based number of the scout, or 0 for the unit itself
+	transport terrain.Transport
+	start     model.Hex
+	end       model.Hex
+	used      int // movement points used
+	allowance int // movement points available
+	// problems are moves that will fail, or that can't be simulated
+	problems []string
+	// warnings are moves through hexes we haven't seen, and moves that
+	// run out of movement points with the placeholder rules
+	warnings []string
+}
+
+// name returns the label for the prediction, like "0138e1" or "0138e1 s2".
+func (p *prediction) name() string {
+	if p.scout == 0 {
+		return p.unit
+	}
+	return fmt.Sprintf("%s s%d", p.unit, p.scout)
+}
+
+// simulate returns the predictions for every unit with movement or
+// scouting orders, using the report for the hex each unit is in now.
+// Followers end the turn wherever their leader does.
+func (s *simulator) simulate(w *workbook, rpt *model.Report) []*prediction {
+	var ids []string
+	for id, unit := range w.Units {
+		if unit.Movement != nil || len(unit.Scouts) != 0 {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	// the tribes move first, and the scouts leave from where the tribe stops
+	ends := map[string]*prediction{}
+	var list []*prediction
+	var followers []*prediction
+	for _, id := range ids {
+		unit := w.Units[id]
+		p := &prediction{unit: id, transport: terrain.Foot, allowance: s.rules.Allowance[terrain.Foot]}
+		p.start, p.end = s.location(w, rpt, id, p)
+		ends[id] = p
+		list = append(list, p)
+		if unit.Movement == nil {
+			continue
+		} else if unit.Movement.Follow != "" {
+			followers = append(followers, p)
+			continue
+		} else if p.start.IsZero() {
+			continue
+		}
+		s.walk(p, unit.Movement.Moves)
+	}
+
+	// a leader without orders stays where it is
+	for _, p := range followers {
+		if follow := w.Units[p.unit].Movement.Follow; ends[follow] == nil {
+			leader := &prediction{unit: follow}
+			leader.start, leader.end = s.location(w, rpt, follow, leader)
+			ends[follow] = leader
+		}
+	}
+	// followers can follow followers, so resolve the chains from the leaders
+	for range followers {
+		for _, p := range followers {
+			if leader := ends[w.Units[p.unit].Movement.Follow]; !leader.end.IsZero() {
+				p.end = leader.end
+			}
+		}
+	}
+	for _, p := range followers {
+		follow := w.Units[p.unit].Movement.Follow
+		if ends[follow].end.IsZero() {
+			p.problems = append(p.problems, fmt.Sprintf("follows %s, which isn't in the report", follow))
+		}
+	}
+
+	for _, id := range ids {
+		unit, from := w.Units[id], ends[id]
+		for n, scout := range unit.Scouts {
+			if len(scout.Moves) == 0 {
+				continue
+			}
+			tr := scoutTransport(scout)
+			p := &prediction{unit: id, scout: n + 1, transport: tr, allowance: s.rules.Allowance[tr]}
+			p.start, p.end = from.end, from.end
+			if p.start.IsZero() {
+				p.problems = append(p.problems, "unit's hex is not known")
+			} else {
+				var moves []*Move
+				for _, mv := range scout.Moves {
+					moves = append(moves, &Move{Direction: mv.Direction, ToLimit: mv.ToLimit})
+				}
+				s.walk(p, moves)
+			}
+			list = append(list, p)
+		}
+	}
+
+	return list
+}
+
+// location returns the hex the unit starts the turn in. The Hex column
+// in the orders overrides the report.
+func (s *simulator) location(w *workbook, rpt *model.Report, id string, p *prediction) (model.Hex, model.Hex) {
+	var h model.Hex
+	if unit := w.Units[id]; unit != nil && unit.Movement != nil && unit.Movement.Hex != "" {
+		var err error
+		if h, err = model.ParseHex(strings.ToUpper(unit.Movement.Hex)); err != nil {
+			p.problems = append(p.problems, fmt.Sprintf("hex: %v", err))
+			return model.Hex{}, model.Hex{}
+		}
+	} else if u, ok := rpt.Units[id]; ok && u.Location != nil {
+		h = u.Location.Current
+	}
+	if h.IsZero() {
+		p.problems = append(p.problems, fmt.Sprintf("not in the %s report", rpt.Turn))
+		return model.Hex{}, model.Hex{}
+	} else if !h.HasGrid() {
+		if s.grid == "" {
+			p.problems = append(p.problems, fmt.Sprintf("%s: grid is not known", h))
+			return model.Hex{}, model.Hex{}
+		}
+		h = h.WithGrid(s.grid)
+	}
+	return h, h
+}
+
+// scoutTransport returns how the scouts travel. Scouts ride only if there
+// is an animal for every scout.
+func scoutTransport(scout *Scout) terrain.Transport {
+	switch {
+	case scout.Scouts <= 0:
+		return terrain.Foot
+	case scout.Horses >= scout.Scouts:
+		return terrain.Horse
+	case scout.Camels >= scout.Scouts:
+		return terrain.Camel
+	case scout.Elephants >= scout.Scouts:
+		return terrain.Elephant
+	}
+	return terrain.Foot
+}
+
+// walk follows the moves from the prediction's end hex. Like the game,
+// the first move that fails ends the movement. A move to the limit
+// repeats until it can't go any further; that is not a problem.
+// The placeholder rules can't say that a unit runs out of movement
+// points, so with them that is a warning marked as an estimate.
+func (s *simulator) walk(p *prediction, moves []*Move) {
+	for n, mv := range moves {
+		if mv.Still {
+			return
+		}
+		dir := mv.Direction
+		if mv.ToLimit {
+			dir = strings.TrimSuffix(dir, "L")
+		}
+		if _, ok := tiles.LookupDirection(dir); !ok {
+			p.problems = append(p.problems, fmt.Sprintf("move %d: %s: can't simulate following rivers or the ocean", n+1, mv.Direction))
+			return
+		}
+		for steps := 0; ; steps++ {
+			if problem, overrun := s.step(p, dir); problem != "" {
+				if mv.ToLimit && steps != 0 {
+					return
+				}
+				problem = fmt.Sprintf("move %d: %s: %s", n+1, mv.Direction, problem)
+				if overrun && s.rules.Placeholder {
+					p.warnings = append(p.warnings, problem+" (estimated costs)")
+				} else {
+					p.problems = append(p.problems, problem)
+				}
+				return
+			} else if !mv.ToLimit {
+				break
+			}
+		}
+	}
+}
+
+// step moves one hex in the direction. It returns why the move fails,
+// or the empty string if it succeeds, and true if the move fails because
+// there aren't enough movement points left.
+func (s *simulator) step(p *prediction, dir string) (string, bool) {
+	to, ok := p.end.Neighbor(dir)
+	if !ok {
+		return fmt.Sprintf("%s: leaves the map", p.end), false
+	}
+	d, _ := tiles.LookupDirection(dir)
+	from, next := s.m.Lookup(p.end.String()), s.m.Lookup(to.String())
+
+	var cost int
+	unseen := next == nil || next.Terrain == terrain.Unknown
+	if unseen {
+		// assume the worst for hexes we haven't seen
+		for _, c := range s.rules.Table(s.season, p.transport) {
+			cost = max(cost, c)
+		}
+	} else if cost, ok = s.rules.Cost(next.Terrain, s.season, p.transport); !ok {
+		return fmt.Sprintf("%s: can't enter %s", to, next.Terrain.Description()), false
+	}
+
+	edges := tiles.SideEdges(from, next, d)
+	extra, ok := s.rules.Crossings.Cost(edges...)
+	if !ok {
+		var names []string
+		for _, e := range edges {
+			if n, ok := s.rules.Crossings[e]; ok && n < 0 && !slices.Contains(names, e.Description()) {
+				names = append(names, e.Description())
+			}
+		}
+		return fmt.Sprintf("%s: can't cross %s", to, strings.Join(names, " or ")), false
+	}
+
+	if p.used+cost+extra > p.allowance {
+		return fmt.Sprintf("%s: needs %d MP, only %d left", to, cost+extra, p.allowance-p.used), true
+	}
+	if unseen {
+		p.warnings = append(p.warnings, fmt.Sprintf("%s: hasn't been seen, assumed %d MP", to, cost))
+	}
+	p.used += cost + extra
+	p.end = to
+	return "", false
+}
+
+// previousTurn returns the turn before a turn like "900-01", which is the
+// report the orders were written from.
+func previousTurn(turn string) string {
+	var year, month int
+	if _, err := fmt.Sscanf(turn, "%d-%d", &year, &month); err != nil {
+		return turn
+	} else if month--; month < 1 {
+		year, month = year-1, 12
+	}
+	return fmt.Sprintf("%03d-%02d", year, month)
+}
@@ -0,0 +1,178 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package main
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/terrain"
+	"github.com/mdhender/chief/internal/tiles"
+	"strings"
+	"testing"
+)
+
+func TestSimulate(t *testing.T) {
+	// a column of prairie from AA 0501 to AA 0510, with a river on the
+	// south side of AA 0505 and ocean in AA 0601
+	m := tiles.New("ZZ")
+	for row := 1; row <= 10; row++ {
+		o := &tiles.Observation{Turn: "900-01", Terrain: terrain.PR}
+		if row == 5 {
+			o.Edges = map[string]edge.Edge{"S": edge.River}
+		}
+		m.Observe(fmt.Sprintf("AA 05%02d", row), o)
+	}
+	m.Observe("AA 0601", &tiles.Observation{Turn: "900-01", Terrain: terrain.O})
+
+	s := &simulator{
+		m: m,
+		rules: &terrain.Rules{
+			Allowance: map[terrain.Transport]int{terrain.Foot: 10, terrain.Horse: 12},
+			Costs: map[terrain.Transport]map[terrain.Terrain]int{
+				terrain.Foot:  {terrain.PR: 3, terrain.SW: 5},
+				terrain.Horse: {terrain.PR: 2, terrain.SW: 6},
+			},
+			Crossings: edge.DefaultCrossings(),
+		},
+		grid: "AA",
+	}
+
+	moves := func(dirs ...string) *Movement {
+		mv := &Movement{}
+		for _, dir := range dirs {
+			switch {
+			case dir == "STILL":
+				mv.Moves = append(mv.Moves, &Move{Still: true})
+			case strings.HasSuffix(dir, "L") && dir != "FOL" && dir != "FRL":
+				mv.Moves = append(mv.Moves, &Move{Direction: dir, ToLimit: true})
+			default:
+				mv.Moves = append(mv.Moves, &Move{Direction: dir})
+			}
+		}
+		return mv
+	}
+	w := &workbook{Units: map[string]*Unit{
+		// move to the limit: three prairie hexes on foot
+		"0138": {Movement: moves("SL"), Scouts: []*Scout{
+			// the scouts ride, and leave from where the tribe stops
+			{Scouts: 1, Horses: 1, Moves: []*ScoutMove{{Direction: "N"}, {Direction: "N"}}},
+			// on foot, because there isn't a horse for every scout
+			{Scouts: 2, Horses: 1, Moves: []*ScoutMove{{Direction: "SL", ToLimit: true}}},
+		}},
+		// the fourth move is more than the budget
+		"0238": {Movement: moves("S", "S", "S", "S", "S")},
+		// the river can't be crossed
+		"0338": {Movement: &Movement{Hex: "aa 0505", Moves: moves("S").Moves}},
+		// the hex south of AA 0510 hasn't been seen, so it costs the most
+		"0438": {Movement: &Movement{Hex: "AA 0510", Moves: moves("S", "S").Moves}},
+		// the ocean can't be entered
+		"0538": {Movement: &Movement{Hex: "AA 0602", Moves: moves("N").Moves}},
+		// following rivers can't be simulated
+		"0638": {Movement: &Movement{Hex: "AA 0501", Moves: moves("S", "FOR", "S").Moves}},
+		// standing still ends the movement
+		"0738": {Movement: &Movement{Hex: "AA 0501", Moves: moves("S", "STILL", "S").Moves}},
+		// a chain of followers ends with the leader
+		"0138e1": {Movement: &Movement{Follow: "0138e2"}},
+		"0138e2": {Movement: &Movement{Follow: "0138"}},
+		// a leader without orders stays where it is
+		"0238e1": {Movement: &Movement{Follow: "0238c1"}},
+		// a leader that isn't anywhere
+		"0338e1": {Movement: &Movement{Follow: "0999"}},
+		// not in the report
+		"0838": {Movement: moves("S")},
+	}}
+	rpt := &model.Report{Turn: "900-01", Units: map[string]*model.Unit{}}
+	for id, hex := range map[string]string{"0138": "AA 0501", "0238": "## 0501", "0238c1": "AA 0509", "0138e1": "AA 0701", "0138e2": "AA 0702", "0238e1": "AA 0702", "0338e1": "AA 0702"} {
+		h, err := model.ParseHex(hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rpt.Units[id] = &model.Unit{Id: id, Location: &model.UnitLocation{Current: h}}
+	}
+
+	got := map[string]*prediction{}
+	for _, p := range s.simulate(w, rpt) {
+		got[p.name()] = p
+	}
+	for _, tc := range []struct {
+		id        int
+		name      string
+		start     string
+		end       string
+		used      int
+		problem   string // a problem contains this, if not empty
+		warnings  int
+		transport terrain.Transport
+	}{
+		{1, "0138", "AA 0501", "AA 0504", 9, "", 0, terrain.Foot},
+		{2, "0138 s1", "AA 0504", "AA 0502", 4, "", 0, terrain.Horse},
+		{3, "0138 s2", "AA 0504", "AA 0505", 3, "", 0, terrain.Foot},
+		{4, "0238", "AA 0501", "AA 0504", 9, "move 4: S: AA 0505: needs 3 MP, only 1 left", 0, terrain.Foot},
+		{5, "0338", "AA 0505", "AA 0505", 0, "move 1: S: AA 0506: can't cross River", 0, terrain.Foot},
+		{6, "0438", "AA 0510", "AA 0512", 10, "", 2, terrain.Foot},
+		{7, "0538", "AA 0602", "AA 0602", 0, "move 1: N: AA 0601: can't enter", 0, terrain.Foot},
+		{8, "0638", "AA 0501", "AA 0502", 3, "move 2: FOR: can't simulate", 0, terrain.Foot},
+		{9, "0738", "AA 0501", "AA 0502", 3, "", 0, terrain.Foot},
+		{10, "0138e1", "AA 0701", "AA 0504", 0, "", 0, terrain.Foot},
+		{11, "0138e2", "AA 0702", "AA 0504", 0, "", 0, terrain.Foot},
+		{12, "0238e1", "AA 0702", "AA 0509", 0, "", 0, terrain.Foot},
+		{13, "0338e1", "AA 0702", "AA 0702", 0, "follows 0999, which isn't in the report", 0, terrain.Foot},
+		{14, "0838", "", "", 0, "not in the 900-01 report", 0, terrain.Foot},
+	} {
+		p, ok := got[tc.name]
+		if !ok {
+			t.Errorf("%d: %s: expected a prediction: got none\n", tc.id, tc.name)
+			continue
+		}
+		if start := hexString(p.start); start != tc.start {
+			t.Errorf("%d: %s: start: expected %q: got %q\n", tc.id, tc.name, tc.start, start)
+		}
+		if end := hexString(p.end); end != tc.end {
+			t.Errorf("%d: %s: end: expected %q: got %q\n", tc.id, tc.name, tc.end, end)
+		}
+		if p.used != tc.used {
+			t.Errorf("%d: %s: used: expected %d: got %d\n", tc.id, tc.name, tc.used, p.used)
+		}
+		if tc.problem == "" && len(p.problems) != 0 {
+			t.Errorf("%d: %s: expected no problems: got %q\n", tc.id, tc.name, p.problems)
+		} else if tc.problem != "" && (len(p.problems) != 1 || !strings.Contains(p.problems[0], tc.problem)) {
+			t.Errorf("%d: %s: expected problem %q: got %q\n", tc.id, tc.name, tc.problem, p.problems)
+		}
+		if len(p.warnings) != tc.warnings {
+			t.Errorf("%d: %s: expected %d warnings: got %q\n", tc.id, tc.name, tc.warnings, p.warnings)
+		}
+		if p.transport != tc.transport {
+			t.Errorf("%d: %s: transport: expected %s: got %s\n", tc.id, tc.name, tc.transport, p.transport)
+		}
+	}
+	if len(got) != 14 {
+		t.Errorf("expected 14 predictions: got %d\n", len(got))
+	}
+
+	// with the placeholder rules, running out of movement points is only a warning
+	s.rules.Placeholder = true
+	w = &workbook{Units: map[string]*Unit{"0238": w.Units["0238"], "0338": w.Units["0338"]}}
+	for _, p := range s.simulate(w, rpt) {
+		switch p.name() {
+		case "0238":
+			if hexString(p.end) != "AA 0504" || len(p.problems) != 0 || len(p.warnings) != 1 || !strings.HasSuffix(p.warnings[0], "only 1 left (estimated costs)") {
+				t.Errorf("placeholder: 0238: expected AA 0504 and an estimated warning: got %s %q %q\n", p.end, p.problems, p.warnings)
+			}
+		case "0338":
+			// the river is still an error
+			if len(p.problems) != 1 || len(p.warnings) != 0 {
+				t.Errorf("placeholder: 0338: expected a problem: got %q %q\n", p.problems, p.warnings)
+			}
+		}
+	}
+}
+
+// hexString returns the hex, or the empty string if it is zero.
+func hexString(h model.Hex) string {
+	if h.IsZero() {
+		return ""
+	}
+	return h.String()
+}
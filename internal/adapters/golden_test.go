@@ -0,0 +1,66 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"github.com/mdhender/chief/internal/golden"
+	"github.com/mdhender/chief/internal/model"
+	pigeon "github.com/mdhender/chief/internal/parsers/pigeon/turnrpt"
+	parsers "github.com/mdhender/chief/internal/parsers/turnrpt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestGolden converts the corpus to the model with the parsers that can
+// read it. The turnrpt scanner can't, so it is tested with sections.
+func TestGolden(t *testing.T) {
+	testdata := filepath.Join("..", "..", "testdata")
+	for _, path := range golden.Corpus(t, filepath.Join(testdata, "turn-reports")) {
+		name := golden.Name(path)
+		input, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		t.Run("pigeon/"+name, func(t *testing.T) {
+			raw, err := pigeon.Parse(filepath.Base(path), pigeon.NormalizeReport(input, "AA"))
+			rpt, ok := raw.(*pigeon.Report)
+			if !ok {
+				t.Fatalf("parse: %v\n", err)
+			}
+			rpt.Clan = "0999"
+			compareModel(t, filepath.Join(testdata, "golden", "adapters", "pigeon", name+".json"), func() (*model.Report, error) {
+				return PigeonReportToModel(rpt)
+			})
+		})
+
+		t.Run("parsers-turnrpt/"+name, func(t *testing.T) {
+			rpt, err := parsers.ParseInput(filepath.Base(path), input)
+			if rpt == nil {
+				t.Skipf("parse: %v\n", err)
+			}
+			compareModel(t, filepath.Join(testdata, "golden", "adapters", "parsers-turnrpt", name+".json"), func() (*model.Report, error) {
+				return ParsedReportToModel(rpt)
+			})
+		})
+	}
+}
+
+// compareModel checks the converted report, or the error, against the golden file.
+func compareModel(t *testing.T, path string, convert func() (*model.Report, error)) {
+	t.Helper()
+	m, err := convert()
+	var msg string
+	if err != nil {
+		msg = err.Error()
+	}
+	golden.Compare(t, path, struct {
+		Report *model.Report `json:"report"`
+		Error  string        `json:"error,omitempty"`
+	}{
+		Report: m,
+		Error:  msg,
+	})
+}
@@ -0,0 +1,32 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"github.com/mdhender/chief/internal/model"
+	"strings"
+)
+
+// unitKind returns the kind of unit from the unit id.
+// Clans are 0138, tribes are 1138, couriers are 0138c1, and elements are 0138e1.
+func unitKind(id string) string {
+	switch {
+	case strings.IndexByte(id, 'c') != -1:
+		return "courier"
+	case strings.IndexByte(id, 'e') != -1:
+		return "element"
+	case strings.HasPrefix(id, "0"):
+		return "clan"
+	}
+	return "tribe"
+}
+
+// newUnit returns a unit with the location set.
+func newUnit(id string, current, previous model.Hex) *model.Unit {
+	return &model.Unit{
+		Id:       id,
+		Kind:     unitKind(id),
+		Location: &model.UnitLocation{Current: current, StartedIn: previous},
+	}
+}
@@ -0,0 +1,143 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/terrain"
+	"regexp"
+	"strings"
+)
+
+var (
+	// reStep matches a successful step like "N-PR" or "SE-GH".
+	reStep = regexp.MustCompile(`^(NE|SE|SW|NW|N|S)-([A-Z][A-Z ]*)$`)
+	// reCantMove matches a move blocked by the ocean, like "Can't Move on Ocean to N of HEX".
+	reCantMove = regexp.MustCompile(`^Can't Move on (\w+) to (NE|SE|SW|NW|N|S) of HEX$`)
+	// reNoFord matches a move blocked by a river, like "No Ford on River to S of HEX".
+	reNoFord = regexp.MustCompile(`^No Ford on (\w+) to (NE|SE|SW|NW|N|S) of HEX$`)
+	// reNotEnough matches a move that ran out of movement points,
+	// like "Not enough M.P's to move to SE into ROCKY HILLS".
+	reNotEnough = regexp.MustCompile(`(?i)^not enough M\.P's to move to (NE|SE|SW|NW|N|S) into (.+)$`)
+	// reUnitId matches a unit id like "0138" or "0138e1".
+	reUnitId = regexp.MustCompile(`^\d{4}(?:[ce]\d)?$`)
+)
+
+// parseSteps converts the text of a movement line, like
+// "N-PR\ N-GH, River S\ Nothing of interest found", to movements.
+// The steps are separated by backslashes.
+// A step that isn't a move adds its details to the previous move.
+func parseSteps(text string) (moves []*model.Movement) {
+	for _, step := range strings.Split(text, `\`) {
+		step = strings.TrimSpace(step)
+		if step == "" {
+			continue
+		}
+		move, details := parseStep(step)
+		if move != nil {
+			moves = append(moves, move)
+		} else if len(moves) == 0 {
+			// details before the first move are lost
+			continue
+		}
+		last := moves[len(moves)-1]
+		last.Result.Edges, last.Result.Found = parseDetails(details, last.Result.Edges, last.Result.Found)
+	}
+	return moves
+}
+
+// parseStep converts a single step to a movement.
+// It returns the movement (nil if the step isn't a move)
+// and the comma separated details that follow the move.
+func parseStep(step string) (*model.Movement, []string) {
+	fields := strings.Split(step, ",")
+	head, details := strings.TrimSpace(fields[0]), fields[1:]
+
+	if m := reStep.FindStringSubmatch(head); m != nil {
+		t, _ := terrain.Lookup(m[2])
+		return &model.Movement{Direction: m[1], Result: &model.MovementResult{Terrain: t}}, details
+	} else if m := reCantMove.FindStringSubmatch(head); m != nil {
+		move := failedMove(m[2], &model.MovementFailure{OceanCoast: true})
+		if e, ok := edge.Lookup(m[1]); ok {
+			move.Result.Edges = map[string]*edge.Edge{m[2]: &e}
+		}
+		return move, details
+	} else if m := reNoFord.FindStringSubmatch(head); m != nil {
+		move := failedMove(m[2], &model.MovementFailure{NoFord: true})
+		if e, ok := edge.Lookup(m[1]); ok {
+			move.Result.Edges = map[string]*edge.Edge{m[2]: &e}
+		}
+		return move, details
+	} else if m := reNotEnough.FindStringSubmatch(head); m != nil {
+		return failedMove(m[1], &model.MovementFailure{NotEnoughMp: true}), details
+	}
+
+	// not a move, so everything is details
+	return nil, fields
+}
+
+// failedMove returns a movement that failed for the given reason.
+func failedMove(direction string, failure *model.MovementFailure) *model.Movement {
+	return &model.Movement{Direction: direction, Result: &model.MovementResult{Failed: failure}}
+}
+
+// parseDetails adds the details of a hex, like "River S SE", "O N" or
+// "Ford NE", to the edges and found items. Anything that isn't an edge
+// is added to found, except unit ids and "Nothing of interest found."
+func parseDetails(details []string, edges map[string]*edge.Edge, found []string) (map[string]*edge.Edge, []string) {
+	for _, detail := range details {
+		detail = strings.TrimSpace(detail)
+		if detail == "" || reUnitId.MatchString(detail) || strings.EqualFold(detail, "Nothing of interest found") {
+			continue
+		}
+		words := strings.Fields(detail)
+		e, ok := edge.Lookup(words[0])
+		ok = ok && len(words) > 1
+		for _, d := range words[1:] {
+			ok = ok && isDirection(d)
+		}
+		if !ok {
+			found = append(found, detail)
+			continue
+		}
+		if edges == nil {
+			edges = make(map[string]*edge.Edge)
+		}
+		for _, d := range words[1:] {
+			ee := e
+			edges[d] = &ee
+		}
+	}
+	return edges, found
+}
+
+// isDirection returns true if the string is a direction on the map.
+func isDirection(s string) bool {
+	switch s {
+	case "N", "NE", "SE", "S", "SW", "NW":
+		return true
+	}
+	return false
+}
+
+// locateMoves sets the From and To hexes for the movements, starting at
+// the given hex. Failed moves end in the hex they started in.
+// It stops if the location can't be determined, for example if a move
+// leaves a hidden grid.
+func locateMoves(moves []*model.Movement, from model.Hex) model.Hex {
+	for _, move := range moves {
+		if from.IsZero() {
+			break
+		}
+		move.Result.From, move.Result.To = from, from
+		if move.Result.Failed != nil {
+			continue
+		}
+		// to is the zero Hex if the move can't be followed
+		to, _ := from.Neighbor(move.Direction)
+		move.Result.To, from = to, to
+	}
+	return from
+}
@@ -0,0 +1,148 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/model"
+	pigeon "github.com/mdhender/chief/internal/parsers/pigeon/turnrpt"
+	"github.com/mdhender/chief/internal/terrain"
+	"strings"
+	"testing"
+)
+
+func TestParseSteps(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect string // direction, terrain or failure, edges and found for each move
+	}{
+		{1, `N-PR\ N-GH, River S SE\ Nothing of interest found`, "N PR; N GH SE:R S:R"},
+		{2, `SE-PR, O N, 0138e1, Find Iron`, "SE PR N:OC Find Iron"},
+		{3, `Can't Move on Ocean to N of HEX`, "N ocean N:OC"},
+		{4, `No Ford on River to S of HEX, Ford NE`, "S ford NE:RF S:R"},
+		{5, `N-PR\ Not enough M.P's to move to SE into ROCKY HILLS`, "N PR; SE mp"},
+		{6, `Nothing of interest found\ N-SW`, "N SW"},
+		{7, `\`, ""},
+	} {
+		var got []string
+		for _, move := range parseSteps(tc.input) {
+			got = append(got, moveString(move))
+		}
+		if s := strings.Join(got, "; "); s != tc.expect {
+			t.Errorf("%d: expected %q: got %q\n", tc.id, tc.expect, s)
+		}
+	}
+}
+
+// moveString returns a short description of the move for comparing.
+func moveString(move *model.Movement) string {
+	fields := []string{move.Direction}
+	switch f := move.Result.Failed; {
+	case f == nil:
+		fields = append(fields, move.Result.Terrain.String())
+	case f.OceanCoast:
+		fields = append(fields, "ocean")
+	case f.NoFord:
+		fields = append(fields, "ford")
+	case f.NotEnoughMp:
+		fields = append(fields, "mp")
+	}
+	for _, d := range []string{"N", "NE", "SE", "S", "SW", "NW"} {
+		if e, ok := move.Result.Edges[d]; ok {
+			fields = append(fields, d+":"+edge.Codes[*e])
+		}
+	}
+	return strings.Join(append(fields, move.Result.Found...), " ")
+}
+
+func TestLocateMoves(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		from   string
+		input  string
+		expect string // the hexes the moves end in
+		end    string
+	}{
+		{1, "AA 0809", `S-PR\ S-PR`, "AA 0810, AA 0811", "AA 0811"},
+		{2, "AA 0809", `S-PR\ Not enough M.P's to move to S into PRAIRIE`, "AA 0810, AA 0810", "AA 0810"},
+		{3, "BA 0101", `N-PR\ S-PR`, "AA 0121, BA 0101", "BA 0101"},
+		{4, "AA 0101", `N-PR\ S-PR`, "", ""},
+		{5, "## 0809", `S-PR`, "## 0810", "## 0810"},
+	} {
+		from, err := model.ParseHex(tc.from)
+		if err != nil {
+			t.Fatalf("%d: %v\n", tc.id, err)
+		}
+		moves := parseSteps(tc.input)
+		end := locateMoves(moves, from)
+		var got []string
+		for _, move := range moves {
+			if !move.Result.To.IsZero() {
+				got = append(got, move.Result.To.String())
+			}
+		}
+		if s := strings.Join(got, ", "); s != tc.expect {
+			t.Errorf("%d: expected %q: got %q\n", tc.id, tc.expect, s)
+		}
+		if end.String() != tc.end {
+			t.Errorf("%d: end: expected %q: got %q\n", tc.id, tc.end, end)
+		}
+	}
+}
+
+func TestPigeonMovementTerrain(t *testing.T) {
+	// the terrain codes in the steps are the ones in the turn reports
+	for _, code := range []string{"PR", "GH", "SW", "RH"} {
+		moves := parseSteps("N-" + code)
+		if len(moves) != 1 {
+			t.Fatalf("%s: expected 1 move: got %d\n", code, len(moves))
+		}
+		if want, _ := terrain.Lookup(code); moves[0].Result.Terrain != want {
+			t.Errorf("%s: expected %v: got %v\n", code, want, moves[0].Result.Terrain)
+		}
+	}
+}
+
+func TestParseDetails(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect string // edges and found
+	}{
+		{1, "River S SE, Ford NE", "NE:RF SE:R S:R"},
+		{2, "O N NW", "N:OC NW:OC"},
+		{3, "0138, 0138e1, Nothing of interest found", ""},
+		{4, "Find Iron, River, River X", "Find Iron River River X"},
+		{5, "", ""},
+	} {
+		edges, found := parseDetails(strings.Split(tc.input, ","), nil, nil)
+		move := &model.Movement{Result: &model.MovementResult{Edges: edges, Found: found}}
+		if s := strings.TrimSpace(moveString(move)); s != tc.expect {
+			t.Errorf("%d: expected %q: got %q\n", tc.id, tc.expect, s)
+		}
+	}
+}
+
+func TestPigeonMovement(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  *pigeon.Movement
+		expect string // direction, terrain or failure, edges and found
+	}{
+		{1, &pigeon.Movement{Direction: "N", Terrain: "PR", Info: ", River S"}, "N PR S:R"},
+		{2, &pigeon.Movement{Direction: "N", Failed: true, Info: "Can't Move on Ocean to N of HEX"}, "N ocean N:OC"},
+		{3, &pigeon.Movement{Direction: "S", Failed: true, Info: "No Ford on River to S of HEX, Ford NE"}, "S ford NE:RF S:R"},
+		{4, &pigeon.Movement{Direction: "SW", Failed: true, Info: "Blocked by the locals, O N"}, "SW N:OC Blocked by the locals"},
+		{5, &pigeon.Movement{Stay: true}, ""},
+	} {
+		var got string
+		if move := pigeonMovement(tc.input); move != nil {
+			got = moveString(move)
+		}
+		if got != tc.expect {
+			t.Errorf("%d: expected %q: got %q\n", tc.id, tc.expect, got)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/model"
+	parsers "github.com/mdhender/chief/internal/parsers/turnrpt"
+	"github.com/mdhender/chief/internal/terrain"
+)
+
+// ParsedReportToModel converts a report from the parsers/turnrpt package to the model.
+func ParsedReportToModel(rpt *parsers.Report) (*model.Report, error) {
+	m := &model.Report{
+		FileName: rpt.FileName,
+		Clan:     rpt.ClanId,
+		Units:    make(map[string]*model.Unit),
+	}
+	if rpt.Current != nil {
+		m.Turn = rpt.Current.Turn
+	}
+
+	for _, tribe := range rpt.Tribes {
+		current, err := model.ParseHex(tribe.Hex)
+		if err != nil {
+			return nil, fmt.Errorf("unit %s: current hex: %w", tribe.Id, err)
+		}
+		previous, err := model.ParseHex(tribe.StartingHex)
+		if err != nil {
+			return nil, fmt.Errorf("unit %s: previous hex: %w", tribe.Id, err)
+		}
+		unit := newUnit(tribe.Id, current, previous)
+		for _, s := range tribe.Scouts {
+			if unit.Scouts == nil {
+				unit.Scouts = make(map[string]*model.Scout)
+			}
+			scout := &model.Scout{Id: s.Id}
+			for _, mr := range s.MovementResults {
+				move := &model.Movement{Direction: mr.Direction, Result: &model.MovementResult{Found: mr.Found}}
+				if !mr.Succeeded {
+					move.Result.Failed = &model.MovementFailure{}
+				} else {
+					move.Result.Terrain, _ = terrain.Lookup(mr.Terrain)
+				}
+				scout.Scout = append(scout.Scout, move)
+			}
+			unit.Scouts[scout.Id] = scout
+		}
+		m.Units[unit.Id] = unit
+	}
+
+	return m, nil
+}
@@ -0,0 +1,192 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/model"
+	pigeon "github.com/mdhender/chief/internal/parsers/pigeon/turnrpt"
+	"github.com/mdhender/chief/internal/terrain"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PigeonReportToModel converts a report from the pigeon parser to the model.
+func PigeonReportToModel(rpt *pigeon.Report) (*model.Report, error) {
+	m := &model.Report{
+		FileName: rpt.FileName,
+		Clan:     rpt.Clan,
+		Turn:     rpt.Turn,
+		Units:    make(map[string]*model.Unit),
+	}
+
+	for id, tr := range rpt.T {
+		current, err := model.ParseHex(tr.CurrentHex)
+		if err != nil {
+			return nil, fmt.Errorf("unit %s: current hex: %w", id, err)
+		}
+		previous, err := model.ParseHex(tr.StartingHex)
+		if err != nil {
+			return nil, fmt.Errorf("unit %s: previous hex: %w", id, err)
+		}
+		if m.Turn == "" {
+			m.Turn = tr.Turn
+		}
+
+		unit := newUnit(id, current, previous)
+		for _, err := range tr.Errors {
+			unit.Notes = append(unit.Notes, &model.Note{Text: err.Error()})
+		}
+		if tr.TribeMovement != nil {
+			unit.Follows = tr.TribeMovement.Follows
+			for _, mv := range tr.TribeMovement.Movement {
+				if move := pigeonMovement(mv); move != nil {
+					unit.Movement = append(unit.Movement, move)
+				}
+			}
+			// units that follow don't report their moves
+			if unit.Follows == "" {
+				if end := locateMoves(unit.Movement, previous); !end.IsZero() && end != current {
+					unit.Notes = append(unit.Notes, &model.Note{Hex: end, Text: fmt.Sprintf("movement ends in %s, not %s", end, current)})
+				}
+			}
+		}
+		if tr.ScoutActions != nil {
+			// scouts leave from the hex the unit ended the turn in
+			for _, sm := range tr.ScoutActions.Movements {
+				if unit.Scouts == nil {
+					unit.Scouts = make(map[string]*model.Scout)
+				}
+				scout := &model.Scout{Id: strconv.Itoa(sm.Id), Scout: parseSteps(sm.Bleet)}
+				locateMoves(scout.Scout, current)
+				unit.Scouts[scout.Id] = scout
+			}
+		}
+		if tr.UnitStatus != nil {
+			unit.Check = &model.Check{Hex: current}
+			unit.Check.Terrain, _ = terrain.Lookup(tr.UnitStatus.Terrain)
+			unit.Check.Edges, unit.Check.Found = parseDetails(strings.Split(tr.UnitStatus.Bleet, ","), nil, nil)
+		}
+		if tr.People != nil {
+			unit.People = &model.People{
+				Warriors: tr.People.Warriors,
+				Active:   tr.People.Active,
+				Inactive: tr.People.Inactive,
+			}
+		}
+		if p := tr.Possessions; p != nil {
+			unit.Inventory = &model.Inventory{}
+			if p.Animals != nil {
+				unit.Inventory.Animals = p.Animals.Items
+			}
+			if p.Minerals != nil {
+				unit.Inventory.Minerals = p.Minerals.Items
+			}
+			if p.WarEquipment != nil {
+				unit.Inventory.WarEquipment = p.WarEquipment.Items
+			}
+			if p.FinishedGoods != nil {
+				unit.Inventory.FinishedGoods = p.FinishedGoods.Items
+			}
+			if p.RawMaterials != nil {
+				unit.Inventory.RawMaterials = p.RawMaterials.Items
+			}
+			if p.Ships != nil {
+				unit.Inventory.Ships = p.Ships.Items
+			}
+		}
+		if tr.Skills != nil {
+			unit.Skills = tr.Skills.Levels
+		}
+		if tr.Morale != nil {
+			unit.Morale = tr.Morale.Value
+		}
+		if tr.Weight != nil {
+			unit.Weight = tr.Weight.Value
+		}
+
+		m.Units[id] = unit
+	}
+
+	if rpt.Transfers != nil {
+		for _, t := range rpt.Transfers.Transfers {
+			m.Transfers = append(m.Transfers, &model.Transfer{From: t.From, To: t.To, Item: t.Item, Quantity: t.Quantity})
+		}
+	}
+
+	if rpt.Settlements != nil {
+		for _, st := range rpt.Settlements.Settlements {
+			hex, err := model.ParseHex(st.Hex)
+			if err != nil {
+				return nil, fmt.Errorf("settlement %q: %w", st.Name, err)
+			}
+			settlement := &model.Settlement{Hex: hex, Name: st.Name, Note: st.Note, Type: st.Type, SubType: st.SubType, Unit: m.Clan}
+			if unit, seen, started := settlementUnit(m, hex); unit != "" {
+				settlement.Unit, settlement.Hex = unit, seen
+				settlement.UnitInferred, settlement.Started = true, started
+			}
+			m.Settlements = append(m.Settlements, settlement)
+		}
+	}
+
+	return m, nil
+}
+
+// settlementUnit returns the unit that reported the settlement and the hex
+// it saw it in. The report doesn't say, so it is the first unit (by id) that
+// ended the turn in the hex, or else the first that started in it, which is
+// returned as true. Hexes the units only moved through are skipped, since
+// their grid can't be resolved. The hex is the unit's, which has the grid
+// when the report hid it in the settlement list.
+// It returns an empty string if no unit was in the hex.
+func settlementUnit(m *model.Report, hex model.Hex) (string, model.Hex, bool) {
+	var ids []string
+	for id := range m.Units {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	same := func(h model.Hex) bool {
+		if h.IsZero() || h.Col != hex.Col || h.Row != hex.Row {
+			return false
+		}
+		return h.Grid == hex.Grid || !h.HasGrid() || !hex.HasGrid()
+	}
+	for _, id := range ids {
+		if loc := m.Units[id].Location; loc != nil && same(loc.Current) {
+			return id, loc.Current.WithGrid(hex.Grid), false
+		}
+	}
+	for _, id := range ids {
+		if loc := m.Units[id].Location; loc != nil && same(loc.StartedIn) {
+			return id, loc.StartedIn.WithGrid(hex.Grid), true
+		}
+	}
+	return "", hex, false
+}
+
+// pigeonMovement converts a single move. It returns nil if the unit stayed
+// in place, since that isn't a movement for the mapper.
+//
+// The parser keeps the text of failed moves in Info; for successful
+// moves, Info is the details (like ", River S") that follow the terrain.
+func pigeonMovement(mv *pigeon.Movement) *model.Movement {
+	if mv.Stay {
+		return nil
+	}
+	if mv.Failed {
+		move, details := parseStep(mv.Info)
+		if move == nil {
+			// we know it failed, but not why
+			move = &model.Movement{Direction: mv.Direction, Result: &model.MovementResult{Failed: &model.MovementFailure{}}}
+		}
+		move.Result.Edges, move.Result.Found = parseDetails(details, move.Result.Edges, move.Result.Found)
+		return move
+	}
+	move := &model.Movement{Direction: mv.Direction, Result: &model.MovementResult{}}
+	move.Result.Terrain, _ = terrain.Lookup(mv.Terrain)
+	move.Result.Edges, move.Result.Found = parseDetails(strings.Split(mv.Info, ","), nil, nil)
+	return move
+}
@@ -0,0 +1,68 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"github.com/mdhender/chief/internal/model"
+	"testing"
+)
+
+func TestSettlementUnit(t *testing.T) {
+	hex := func(s string) model.Hex {
+		h, err := model.ParseHex(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	m := &model.Report{Units: map[string]*model.Unit{}}
+	for _, u := range []struct {
+		id, started, current, through string
+	}{
+		{"0138", "AA 0102", "AA 0101", ""},
+		{"0138e1", "## 0504", "## 0505", "## 0606"},
+		{"0100", "AA 0909", "AA 0910", ""},
+		{"0200", "AA 0808", "AA 0909", ""},
+	} {
+		unit := &model.Unit{Id: u.id, Location: &model.UnitLocation{StartedIn: hex(u.started), Current: hex(u.current)}}
+		if u.through != "" {
+			unit.Movement = []*model.Movement{{Direction: "SE", Result: &model.MovementResult{From: hex(u.current), To: hex(u.through)}}}
+		}
+		m.Units[u.id] = unit
+	}
+
+	for _, tc := range []struct {
+		id      int
+		hex     string
+		unit    string
+		seen    string
+		started bool
+	}{
+		{1, "AA 0101", "0138", "AA 0101", false},
+		{2, "AA 0102", "0138", "AA 0102", true},
+		// the unit's hex has the grid when the settlement list hides it
+		{3, "## 0101", "0138", "AA 0101", false},
+		// and the settlement list has it when the unit's hex hides it
+		{4, "AC 0505", "0138e1", "AC 0505", false},
+		{5, "## 0505", "0138e1", "## 0505", false},
+		{6, "AA 0504", "0138e1", "AA 0504", true},
+		// a unit that ended in the hex is picked over one that started in it
+		{7, "AA 0909", "0200", "AA 0909", false},
+		// hexes the unit only moved through aren't used
+		{8, "AA 0606", "", "AA 0606", false},
+		// nor hexes in another grid
+		{9, "AB 0101", "", "AB 0101", false},
+	} {
+		unit, seen, started := settlementUnit(m, hex(tc.hex))
+		if unit != tc.unit {
+			t.Errorf("%d: unit: expected %q: got %q\n", tc.id, tc.unit, unit)
+		}
+		if seen.String() != tc.seen {
+			t.Errorf("%d: hex: expected %q: got %q\n", tc.id, tc.seen, seen)
+		}
+		if started != tc.started {
+			t.Errorf("%d: started: expected %v: got %v\n", tc.id, tc.started, started)
+		}
+	}
+}
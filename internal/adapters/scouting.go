@@ -3,8 +3,85 @@
 
 package adapters
 
-import "github.com/mdhender/chief/internal/stores/json/scouting"
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/board"
+	"github.com/mdhender/chief/internal/coords"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/stores/json/scouting"
+	"github.com/mdhender/chief/internal/terrain"
+	"sort"
+)
 
-func ScoutingResultsToBoard(sr *scouting.Results) error {
-	panic("!")
+// ScoutingResultsToBoard returns a board with the terrain seen by the units
+// and their scouts. The board covers every grid with a hex in the results and
+// starts at the top left corner of the first grid.
+//
+// Hexes with a hidden ("##") grid can't be placed and are skipped.
+// It returns an error if no hexes can be placed.
+func ScoutingResultsToBoard(sr *scouting.Results) (*board.Board, error) {
+	type seen struct {
+		hex     model.Hex
+		terrain terrain.Terrain
+	}
+	// the units and scouts are visited in order, so when they report
+	// different terrain for a hex, the board is the same every run
+	var ids []string
+	for id := range sr.Units {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var list []seen
+	for _, id := range ids {
+		unit := sr.Units[id]
+		if unit.Location != nil {
+			list = append(list, seen{hex: unit.Location.StartedIn}, seen{hex: unit.Location.Current})
+		}
+		var scouts []string
+		for id := range unit.Scouts {
+			scouts = append(scouts, id)
+		}
+		sort.Strings(scouts)
+		moves := unit.Movement
+		for _, id := range scouts {
+			moves = append(moves, unit.Scouts[id].Scout...)
+		}
+		for _, move := range moves {
+			if move.Result != nil && move.Result.Failed == nil {
+				list = append(list, seen{hex: move.Result.To, terrain: move.Result.Terrain})
+			}
+		}
+		if unit.Check != nil {
+			list = append(list, seen{hex: unit.Check.Hex, terrain: unit.Check.Terrain})
+		}
+	}
+
+	// find the grids that the board covers
+	minCol, minRow, maxCol, maxRow, placed := 25, 25, 0, 0, 0
+	for _, s := range list {
+		if !s.hex.HasGrid() {
+			continue
+		}
+		col, row := int(s.hex.Grid[1]-'A'), int(s.hex.Grid[0]-'A')
+		minCol, maxCol = min(minCol, col), max(maxCol, col)
+		minRow, maxRow = min(minRow, row), max(maxRow, row)
+		placed++
+	}
+	if placed == 0 {
+		return nil, fmt.Errorf("scouting results: no hexes with a known grid")
+	}
+
+	b := board.New((maxCol-minCol+1)*coords.Columns, (maxRow-minRow+1)*coords.Rows)
+	for _, s := range list {
+		if !s.hex.HasGrid() {
+			continue
+		}
+		col, row := s.hex.Global()
+		x, y := col-1-minCol*coords.Columns, row-1-minRow*coords.Rows
+		// don't let a hex we only passed through hide the terrain we saw
+		if s.terrain != terrain.Unknown || !b.IsSet(x, y) {
+			b.SetTerrain(x, y, s.terrain)
+		}
+	}
+	return b, nil
 }
@@ -0,0 +1,129 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"github.com/mdhender/chief/internal/model"
+	pigeon "github.com/mdhender/chief/internal/parsers/pigeon/turnrpt"
+	"github.com/mdhender/chief/internal/terrain"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestScoutingResultsToBoard(t *testing.T) {
+	path := filepath.Join("..", "..", "testdata", "turn-reports", "0999.900-03.failed-moves.txt")
+	input, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := pigeon.Parse(path, pigeon.NormalizeReport(input, "AA"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := PigeonReportToModel(raw.(*pigeon.Report))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := ScoutingResultsToBoard(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the board is the whole of grid AA
+	if minCol, minRow, maxCol, maxRow := b.Bounds(); minCol != 0 || minRow != 0 || maxCol != 30 || maxRow != 21 {
+		t.Errorf("bounds: expected 0 0 30 21: got %d %d %d %d\n", minCol, minRow, maxCol, maxRow)
+	}
+	for _, unit := range m.Units {
+		if unit.Check == nil {
+			continue
+		}
+		col, row := unit.Check.Hex.Global()
+		if got := b.GetTerrain(col-1, row-1); got != unit.Check.Terrain {
+			t.Errorf("%s: %s: expected %v: got %v\n", unit.Id, unit.Check.Hex, unit.Check.Terrain, got)
+		}
+	}
+	for _, move := range m.Units["0999"].Movement {
+		if move.Result.Failed != nil {
+			continue
+		}
+		col, row := move.Result.To.Global()
+		if got := b.GetTerrain(col-1, row-1); got == terrain.Unknown {
+			t.Errorf("0999: %s: expected terrain: got %v\n", move.Result.To, got)
+		}
+	}
+}
+
+func TestScoutingResultsToBoardGrids(t *testing.T) {
+	hex := func(s string) model.Hex {
+		h, err := model.ParseHex(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	move := func(from, to string, tt terrain.Terrain) *model.Movement {
+		return &model.Movement{Direction: "S", Result: &model.MovementResult{From: hex(from), To: hex(to), Terrain: tt}}
+	}
+
+	// a unit in grid BB passed through a hex that its scout saw
+	r := &model.Report{Units: map[string]*model.Unit{
+		"0138": {
+			Id:       "0138",
+			Location: &model.UnitLocation{StartedIn: hex("BB 0101"), Current: hex("BB 0103")},
+			Movement: []*model.Movement{move("BB 0101", "BB 0102", terrain.Unknown), move("BB 0102", "BB 0103", terrain.PR)},
+			Scouts: map[string]*model.Scout{
+				"1": {Id: "1", Scout: []*model.Movement{move("BB 0101", "BB 0102", terrain.GH), move("BB 0102", "BC 0102", terrain.SW)}},
+			},
+		},
+		// this is added after the scout, but only passed through the hex
+		"0138e1": {Id: "0138e1", Location: &model.UnitLocation{StartedIn: hex("BB 0102"), Current: hex("## 0101")}},
+	}}
+	b, err := ScoutingResultsToBoard(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the board covers grids BB and BC
+	if _, _, maxCol, maxRow := b.Bounds(); maxCol != 60 || maxRow != 21 {
+		t.Errorf("bounds: expected 60 21: got %d %d\n", maxCol, maxRow)
+	}
+	for _, tc := range []struct {
+		id     int
+		x, y   int
+		expect terrain.Terrain
+	}{
+		{1, 0, 0, terrain.Unknown},
+		{2, 0, 1, terrain.GH},
+		{3, 0, 2, terrain.PR},
+		{4, 30, 1, terrain.SW},
+	} {
+		if !b.IsSet(tc.x, tc.y) {
+			t.Errorf("%d: %d %d: expected a hex: got none\n", tc.id, tc.x, tc.y)
+		} else if got := b.GetTerrain(tc.x, tc.y); got != tc.expect {
+			t.Errorf("%d: %d %d: expected %v: got %v\n", tc.id, tc.x, tc.y, tc.expect, got)
+		}
+	}
+
+	// when units disagree, the last unit by id wins on every run
+	r = &model.Report{Units: map[string]*model.Unit{
+		"0138":   {Id: "0138", Movement: []*model.Movement{move("BB 0104", "BB 0105", terrain.PR)}},
+		"0238":   {Id: "0238", Movement: []*model.Movement{move("BB 0104", "BB 0105", terrain.SW)}},
+		"0138e1": {Id: "0138e1", Movement: []*model.Movement{move("BB 0104", "BB 0105", terrain.GH)}},
+	}}
+	for n := 0; n < 20; n++ {
+		if b, err = ScoutingResultsToBoard(r); err != nil {
+			t.Fatal(err)
+		} else if got := b.GetTerrain(0, 4); got != terrain.SW {
+			t.Fatalf("order: run %d: expected %v: got %v\n", n+1, terrain.SW, got)
+		}
+	}
+
+	// hidden grids can't be placed
+	r = &model.Report{Units: map[string]*model.Unit{
+		"0138": {Id: "0138", Location: &model.UnitLocation{StartedIn: hex("## 0101"), Current: hex("## 0102")}},
+	}}
+	if _, err := ScoutingResultsToBoard(r); err == nil {
+		t.Errorf("hidden: expected error: got nil\n")
+	}
+}
@@ -0,0 +1,64 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/terrain"
+	"github.com/mdhender/chief/internal/turnrpt"
+	"strconv"
+)
+
+// TribeSectionsToModel converts the sections from the turnrpt package to the model.
+// If clan is empty, it is taken from the first unit in the report.
+func TribeSectionsToModel(clan string, sections []*turnrpt.TribeSection) (*model.Report, error) {
+	if clan == "" && len(sections) != 0 && len(sections[0].Id) >= 4 {
+		// the clan is the tribe numbered 0xxx, so 1138 belongs to 0138
+		clan = "0" + sections[0].Id[1:4]
+	}
+	m := &model.Report{
+		Clan:  clan,
+		Units: make(map[string]*model.Unit),
+	}
+
+	for _, section := range sections {
+		unit := newUnit(section.Id, turnrptHex(section.CurrHex), turnrptHex(section.PrevHex))
+		if m.Turn == "" && section.Current != nil && section.Current.Year != 0 {
+			m.Turn = fmt.Sprintf("%d-%02d", section.Current.Year, section.Current.Month)
+		}
+		for n, sr := range section.Scouting.Results {
+			if unit.Scouts == nil {
+				unit.Scouts = make(map[string]*model.Scout)
+			}
+			scout := &model.Scout{Id: sr.Id}
+			if scout.Id == "" {
+				scout.Id = strconv.Itoa(n + 1)
+			}
+			for _, mr := range sr.MovementResults {
+				move := &model.Movement{Direction: mr.Direction, Result: &model.MovementResult{Found: mr.Found}}
+				if mr.Failed {
+					move.Result.Failed = &model.MovementFailure{}
+				} else {
+					move.Result.Terrain, _ = terrain.Lookup(mr.Terrain)
+				}
+				scout.Scout = append(scout.Scout, move)
+			}
+			unit.Scouts[scout.Id] = scout
+		}
+		if section.Status != nil {
+			unit.Check = &model.Check{Hex: unit.Location.Current, Terrain: section.Status.Terrain}
+		}
+		m.Units[unit.Id] = unit
+	}
+
+	return m, nil
+}
+
+func turnrptHex(h *turnrpt.Hex) model.Hex {
+	if h == nil {
+		return model.Hex{}
+	}
+	return model.Hex{Grid: h.GridX + h.GridY, Col: h.Col, Row: h.Row}
+}
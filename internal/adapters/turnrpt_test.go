@@ -0,0 +1,73 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package adapters
+
+import (
+	"github.com/mdhender/chief/internal/terrain"
+	"github.com/mdhender/chief/internal/turnrpt"
+	"testing"
+)
+
+func TestTribeSectionsToModel(t *testing.T) {
+	tribe := &turnrpt.TribeSection{
+		Id:      "1138",
+		CurrHex: &turnrpt.Hex{GridX: "A", GridY: "B", Col: 8, Row: 10},
+		PrevHex: &turnrpt.Hex{GridX: "A", GridY: "B", Col: 8, Row: 9},
+		Current: &turnrpt.Turn{Year: 900, Month: 3},
+		Status:  &turnrpt.Status{Terrain: terrain.PR},
+	}
+	tribe.Scouting.Results = []*turnrpt.ScoutingResult{
+		{MovementResults: []turnrpt.MovementResult{
+			{Direction: "N", Terrain: "PR", Found: []string{"1138e1"}},
+			{Direction: "N", Failed: true},
+		}},
+	}
+	element := &turnrpt.TribeSection{Id: "1138e1"}
+
+	for _, tc := range []struct {
+		id     int
+		clan   string
+		expect string
+	}{
+		{1, "0138", "0138"},
+		{2, "", "0138"},
+	} {
+		m, err := TribeSectionsToModel(tc.clan, []*turnrpt.TribeSection{tribe, element})
+		if err != nil {
+			t.Fatalf("%d: %v\n", tc.id, err)
+		}
+		if m.Clan != tc.expect {
+			t.Errorf("%d: clan: expected %q: got %q\n", tc.id, tc.expect, m.Clan)
+		}
+		if m.Turn != "900-03" {
+			t.Errorf("%d: turn: expected %q: got %q\n", tc.id, "900-03", m.Turn)
+		}
+		if len(m.Units) != 2 {
+			t.Fatalf("%d: units: expected 2: got %d\n", tc.id, len(m.Units))
+		}
+		unit := m.Units["1138"]
+		if got := unit.Location.Current.String(); got != "AB 0810" {
+			t.Errorf("%d: current: expected %q: got %q\n", tc.id, "AB 0810", got)
+		}
+		if got := unit.Location.StartedIn.String(); got != "AB 0809" {
+			t.Errorf("%d: previous: expected %q: got %q\n", tc.id, "AB 0809", got)
+		}
+		if unit.Check == nil || unit.Check.Terrain != terrain.PR || unit.Check.Hex != unit.Location.Current {
+			t.Errorf("%d: check: expected prairie in the current hex: got %+v\n", tc.id, unit.Check)
+		}
+		scout := unit.Scouts["1"]
+		if scout == nil || len(scout.Scout) != 2 {
+			t.Fatalf("%d: scout: expected 2 moves: got %+v\n", tc.id, scout)
+		}
+		if move := scout.Scout[0]; move.Result.Failed != nil || move.Result.Terrain != terrain.PR || len(move.Result.Found) != 1 {
+			t.Errorf("%d: scout: expected a move to prairie: got %+v\n", tc.id, move.Result)
+		}
+		if move := scout.Scout[1]; move.Result.Failed == nil {
+			t.Errorf("%d: scout: expected a failed move: got %+v\n", tc.id, move.Result)
+		}
+		if got := m.Units["1138e1"].Location.Current; !got.IsZero() {
+			t.Errorf("%d: element: expected no hex: got %q\n", tc.id, got)
+		}
+	}
+}
@@ -4,7 +4,6 @@
 package board
 
 import (
-	"github.com/mdhender/chief/internal/hexes"
 	"github.com/mdhender/chief/internal/svg"
 	"github.com/mdhender/chief/internal/terrain"
 	"log"
@@ -23,7 +22,6 @@ type Board struct {
 }
 
 type hex struct {
-	coords  hexes.Coordinates
 	terrain terrain.Terrain
 }
 
@@ -74,7 +72,7 @@ func (b *Board) SetTerrain(x, y int, t terrain.Terrain) {
 		return
 	}
 	if b.hexes[y][x] == nil {
-		b.hexes[y][x] = &hex{coords: hexes.NewCoordinates(x, y)}
+		b.hexes[y][x] = &hex{}
 	}
 	b.hexes[y][x].terrain = t
 }
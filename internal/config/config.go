@@ -30,6 +30,9 @@ type Clan struct {
 	Id   string `json:"id"`
 	Root string `json:"root,omitempty"`
 	Docs string `json:"docs,omitempty"`
+	// Grid replaces "##" in hexes in the turn reports.
+	// If it is empty, the hidden grids are kept for the grids solver.
+	Grid string `json:"grid,omitempty"`
 }
 
 // Default returns a Config that has been initialized with
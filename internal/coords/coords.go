@@ -0,0 +1,172 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+// Package coords implements TribeNet map coordinates.
+//
+// The map is 26 by 26 grids, and each grid is 30 columns by 21 rows.
+// A hex is written as the grid letters (row first), then the column
+// and row in the grid, like "AB 0102". Reports write "##" for the grid
+// when it is hidden.
+//
+// The map uses the "even-q" layout: the even columns are shifted down
+// half a hex. Since a grid is an even number of columns wide, a column
+// has the same parity in every grid.
+package coords
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Columns and Rows are the size of a grid.
+const (
+	Columns = 30
+	Rows    = 21
+)
+
+// GridHex is a location on the TribeNet map, like "AB 0102".
+// The grid is two upper-case letters, or "##" when the report
+// hides the grid. Column and row are both 1-based.
+type GridHex struct {
+	Grid string
+	Col  int
+	Row  int
+}
+
+// Parse parses a hex like "AB 0102" or "## 0102".
+// The empty string and "N/A" parse as the zero GridHex.
+func Parse(s string) (GridHex, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "N/A" {
+		return GridHex{}, nil
+	}
+	if len(s) != 7 || s[2] != ' ' {
+		return GridHex{}, fmt.Errorf("invalid hex %q", s)
+	}
+	grid := s[:2]
+	if grid != "##" && !(isUpper(grid[0]) && isUpper(grid[1])) {
+		return GridHex{}, fmt.Errorf("invalid hex %q: grid", s)
+	}
+	col, err := strconv.Atoi(s[3:5])
+	if err != nil || col < 1 || col > Columns {
+		return GridHex{}, fmt.Errorf("invalid hex %q: column", s)
+	}
+	row, err := strconv.Atoi(s[5:7])
+	if err != nil || row < 1 || row > Rows {
+		return GridHex{}, fmt.Errorf("invalid hex %q: row", s)
+	}
+	return GridHex{Grid: grid, Col: col, Row: row}, nil
+}
+
+// FromGlobal returns the hex for a column and row counted from the top left
+// of the map, starting at 1. It returns false if the hex is off the map.
+func FromGlobal(col, row int) (GridHex, bool) {
+	if col < 1 || row < 1 || col > 26*Columns || row > 26*Rows {
+		return GridHex{}, false
+	}
+	return GridHex{
+		Grid: string([]byte{byte('A' + (row-1)/Rows), byte('A' + (col-1)/Columns)}),
+		Col:  (col-1)%Columns + 1,
+		Row:  (row-1)%Rows + 1,
+	}, true
+}
+
+// Global returns the column and row counted from the top left of the map,
+// starting at 1. A hidden grid is treated as "AA".
+func (h GridHex) Global() (col, row int) {
+	if !h.HasGrid() {
+		return h.Col, h.Row
+	}
+	return int(h.Grid[1]-'A')*Columns + h.Col, int(h.Grid[0]-'A')*Rows + h.Row
+}
+
+// IsZero returns true if the hex is not set.
+func (h GridHex) IsZero() bool {
+	return h == GridHex{}
+}
+
+// HasGrid returns true if the grid is known.
+func (h GridHex) HasGrid() bool {
+	return h.Grid != "" && h.Grid != "##"
+}
+
+// WithGrid returns the hex with a hidden ("##") grid replaced.
+func (h GridHex) WithGrid(grid string) GridHex {
+	if h.Grid == "##" {
+		h.Grid = grid
+	}
+	return h
+}
+
+// Cube returns the cube coordinates of the hex on the whole map.
+// A hidden grid is treated as "AA".
+func (h GridHex) Cube() Cube {
+	return cubeOf(h.Global())
+}
+
+// Neighbor returns the hex one step in the direction ("N", "NE", "SE",
+// "S", "SW", or "NW"). It returns false if the direction is not valid.
+// See Step for the rest.
+func (h GridHex) Neighbor(direction string) (GridHex, bool) {
+	d, ok := LookupDirection(direction)
+	if !ok {
+		return GridHex{}, false
+	}
+	return h.Step(d)
+}
+
+// Step returns the hex one step in the direction. Moves may cross into the
+// next grid. It returns false if the move leaves the map, or leaves the grid
+// when the grid is hidden.
+func (h GridHex) Step(d Direction) (GridHex, bool) {
+	if h.IsZero() {
+		return GridHex{}, false
+	}
+	n, ok := h.Cube().Neighbor(d).GridHex()
+	if !ok {
+		return GridHex{}, false
+	} else if !h.HasGrid() {
+		if n.Grid != "AA" {
+			return GridHex{}, false
+		}
+		n.Grid = h.Grid
+	}
+	return n, true
+}
+
+// Distance returns the number of moves between the hexes. A hex in a hidden
+// grid is treated as being in the same grid as the other hex.
+func (h GridHex) Distance(to GridHex) int {
+	if !h.HasGrid() {
+		h = h.WithGrid(to.Grid)
+	}
+	if !to.HasGrid() {
+		to = to.WithGrid(h.Grid)
+	}
+	return h.Cube().Distance(to.Cube())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (h GridHex) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+// String implements the fmt.Stringer interface.
+func (h GridHex) String() string {
+	if h.IsZero() {
+		return ""
+	}
+	return fmt.Sprintf("%s %02d%02d", h.Grid, h.Col, h.Row)
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (h *GridHex) UnmarshalText(b []byte) error {
+	var err error
+	*h, err = Parse(string(b))
+	return err
+}
+
+func isUpper(ch byte) bool {
+	return 'A' <= ch && ch <= 'Z'
+}
@@ -0,0 +1,238 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package coords
+
+import (
+	"encoding/json"
+	"testing"
+	"testing/quick"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect GridHex
+		ok     bool
+	}{
+		{1, "AB 0102", GridHex{Grid: "AB", Col: 1, Row: 2}, true},
+		{2, "## 3021", GridHex{Grid: "##", Col: 30, Row: 21}, true},
+		{3, "", GridHex{}, true},
+		{4, "N/A", GridHex{}, true},
+		{5, "AB 0002", GridHex{}, false},
+		{6, "AB 3101", GridHex{}, false},
+		{7, "AB 0122", GridHex{}, false},
+		{8, "ab 0101", GridHex{}, false},
+		{9, "AB0101", GridHex{}, false},
+		{10, "#A 0101", GridHex{}, false},
+	} {
+		got, err := Parse(tc.input)
+		if tc.ok && err != nil {
+			t.Errorf("%d: %q: expected ok: got %v\n", tc.id, tc.input, err)
+		} else if !tc.ok && err == nil {
+			t.Errorf("%d: %q: expected error: got nil\n", tc.id, tc.input)
+		} else if got != tc.expect {
+			t.Errorf("%d: %q: expected %+v: got %+v\n", tc.id, tc.input, tc.expect, got)
+		}
+	}
+}
+
+func TestStep(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		from   string
+		d      Direction
+		expect string
+		ok     bool
+	}{
+		{1, "AA 0202", N, "AA 0201", true},
+		{2, "AA 0202", NE, "AA 0302", true},
+		{3, "AA 0202", SE, "AA 0303", true},
+		{4, "AA 0202", SW, "AA 0103", true},
+		{5, "AA 0302", NE, "AA 0401", true},
+		{6, "AA 0302", SE, "AA 0402", true},
+		{7, "AA 0302", NW, "AA 0201", true},
+		{8, "AA 3010", SE, "AB 0111", true},
+		{9, "AA 0521", S, "BA 0501", true},
+		{10, "BB 0101", NW, "AA 3021", true},
+		{11, "AA 0101", N, "", false},
+		{12, "## 0521", S, "", false},
+		{13, "## 0809", S, "## 0810", true},
+		{14, "ZZ 3021", SE, "", false},
+		{15, "## 3010", NE, "", false},
+	} {
+		from, err := Parse(tc.from)
+		if err != nil {
+			t.Fatalf("%d: %v\n", tc.id, err)
+		}
+		got, ok := from.Step(tc.d)
+		if ok != tc.ok {
+			t.Errorf("%d: %s %s: expected ok %v: got %v\n", tc.id, tc.from, tc.d, tc.ok, ok)
+		} else if got.String() != tc.expect {
+			t.Errorf("%d: %s %s: expected %q: got %q\n", tc.id, tc.from, tc.d, tc.expect, got.String())
+		}
+	}
+}
+
+func TestDistance(t *testing.T) {
+	for _, tc := range []struct {
+		id       int
+		from, to string
+		expect   int
+	}{
+		{1, "AA 0101", "AA 0101", 0},
+		{2, "AA 0101", "AA 0102", 1},
+		{3, "AA 3010", "AB 0111", 1},
+		{4, "AA 0521", "BA 0501", 1},
+		{5, "AA 0101", "AA 0401", 3},
+		{6, "AA 0101", "AB 0101", 30},
+		{7, "## 0101", "AB 0103", 2},
+	} {
+		from, _ := Parse(tc.from)
+		to, _ := Parse(tc.to)
+		if got := from.Distance(to); got != tc.expect {
+			t.Errorf("%d: %s to %s: expected %d: got %d\n", tc.id, tc.from, tc.to, tc.expect, got)
+		}
+	}
+}
+
+// every hex on the map
+func forEachHex(f func(h GridHex)) {
+	for row := 1; row <= 26*Rows; row++ {
+		for col := 1; col <= 26*Columns; col++ {
+			h, _ := FromGlobal(col, row)
+			f(h)
+		}
+	}
+}
+
+func TestRoundTrips(t *testing.T) {
+	forEachHex(func(h GridHex) {
+		if got, err := Parse(h.String()); err != nil || got != h {
+			t.Fatalf("%s: parse: expected %+v: got %+v %v\n", h, h, got, err)
+		}
+		col, row := h.Global()
+		if got, ok := FromGlobal(col, row); !ok || got != h {
+			t.Fatalf("%s: global: expected %+v: got %+v\n", h, h, got)
+		}
+		c := h.Cube()
+		if c.Q+c.R+c.S != 0 {
+			t.Fatalf("%s: cube: expected q+r+s == 0: got %+v\n", h, c)
+		} else if got, ok := c.GridHex(); !ok || got != h {
+			t.Fatalf("%s: cube: expected %+v: got %+v\n", h, h, got)
+		}
+	})
+}
+
+func TestNeighborsAreSymmetric(t *testing.T) {
+	forEachHex(func(h GridHex) {
+		for d := N; d <= NW; d++ {
+			n, ok := h.Step(d)
+			if !ok {
+				continue
+			}
+			if back, ok := n.Step(d.Add(3)); !ok || back != h {
+				t.Fatalf("%s %s %s: expected %s: got %s\n", h, d, d.Add(3), h, back)
+			} else if h.Distance(n) != 1 {
+				t.Fatalf("%s %s: expected distance 1: got %d\n", h, d, h.Distance(n))
+			}
+		}
+	})
+}
+
+func TestJSON(t *testing.T) {
+	f := func(col, row uint16) bool {
+		h, _ := FromGlobal(int(col)%(26*Columns)+1, int(row)%(26*Rows)+1)
+		data, err := json.Marshal(map[string]GridHex{"hex": h})
+		if err != nil {
+			return false
+		}
+		var got map[string]GridHex
+		return json.Unmarshal(data, &got) == nil && got["hex"] == h
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDistanceIsAMetric(t *testing.T) {
+	hex := func(col, row uint16) GridHex {
+		h, _ := FromGlobal(int(col)%(26*Columns)+1, int(row)%(26*Rows)+1)
+		return h
+	}
+	f := func(ac, ar, bc, br, cc, cr uint16) bool {
+		a, b, c := hex(ac, ar), hex(bc, br), hex(cc, cr)
+		return a.Distance(b) == b.Distance(a) &&
+			(a.Distance(b) == 0) == (a == b) &&
+			a.Distance(c) <= a.Distance(b)+b.Distance(c)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCubeArithmetic(t *testing.T) {
+	// from https://www.redblobgames.com/grids/hexagons/codegen/output/lib.cpp
+	if got := (Cube{1, -3, 2}).Add(Cube{3, -7, 4}); got != (Cube{4, -10, 6}) {
+		t.Errorf("add: expected %v: got %v\n", Cube{4, -10, 6}, got)
+	}
+	if got := (Cube{1, -3, 2}).Subtract(Cube{3, -7, 4}); got != (Cube{-2, 4, -2}) {
+		t.Errorf("subtract: expected %v: got %v\n", Cube{-2, 4, -2}, got)
+	}
+	if got := (Cube{3, -7, 4}).Distance(Cube{}); got != 7 {
+		t.Errorf("distance: expected 7: got %d\n", got)
+	}
+}
+
+func TestLine(t *testing.T) {
+	for _, tc := range []struct {
+		id       int
+		from, to Cube
+		expect   []Cube
+	}{
+		// from https://www.redblobgames.com/grids/hexagons/codegen/output/lib.cpp
+		{1, Cube{}, Cube{1, -5, 4}, []Cube{{0, 0, 0}, {0, -1, 1}, {0, -2, 2}, {1, -3, 2}, {1, -4, 3}, {1, -5, 4}}},
+		{2, Cube{2, -1, -1}, Cube{2, -1, -1}, []Cube{{2, -1, -1}}},
+		{3, Cube{}, Cube{0, 1, -1}, []Cube{{0, 0, 0}, {0, 1, -1}}},
+	} {
+		got := tc.from.Line(tc.to)
+		if len(got) != len(tc.expect) {
+			t.Errorf("%d: expected %v: got %v\n", tc.id, tc.expect, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tc.expect[i] {
+				t.Errorf("%d: expected %v: got %v\n", tc.id, tc.expect, got)
+				break
+			}
+		}
+	}
+}
+
+func TestRing(t *testing.T) {
+	center := Cube{5, -2, -3}
+	for radius := 0; radius <= 4; radius++ {
+		ring := center.Ring(radius)
+		if expect := max(1, 6*radius); len(ring) != expect {
+			t.Errorf("%d: expected %d hexes: got %d\n", radius, expect, len(ring))
+		}
+		seen := map[Cube]bool{}
+		for i, h := range ring {
+			if d := center.Distance(h); d != radius {
+				t.Errorf("%d: %v: expected distance %d: got %d\n", radius, h, radius, d)
+			}
+			if seen[h] {
+				t.Errorf("%d: %v: expected once: got twice\n", radius, h)
+			}
+			seen[h] = true
+			// each hex is next to the one before it, all the way around
+			if next := ring[(i+1)%len(ring)]; radius != 0 && h.Distance(next) != 1 {
+				t.Errorf("%d: %v: expected next to %v\n", radius, h, next)
+			}
+		}
+	}
+	if got := center.Ring(1)[0]; got != center.Neighbor(N) {
+		t.Errorf("expected ring to start at %v: got %v\n", center.Neighbor(N), got)
+	}
+}
@@ -0,0 +1,123 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package coords
+
+import (
+	"math"
+)
+
+// Cube is a hex in cube coordinates, where q + r + s is always zero.
+// The coordinates cover the whole map, so distances and neighbors work
+// across grid boundaries. Q is the global column.
+type Cube struct {
+	Q, R, S int
+}
+
+// cubeDirections are the vectors for each direction.
+var cubeDirections = [6]Cube{
+	N:  {Q: 0, R: -1, S: +1},
+	NE: {Q: +1, R: -1, S: 0},
+	SE: {Q: +1, R: 0, S: -1},
+	S:  {Q: 0, R: +1, S: -1},
+	SW: {Q: -1, R: +1, S: 0},
+	NW: {Q: -1, R: 0, S: +1},
+}
+
+// cubeOf converts a global column and row to cube coordinates.
+// The even columns are shifted down.
+func cubeOf(col, row int) Cube {
+	q, r := col, row-(col+(col&1))/2
+	return Cube{Q: q, R: r, S: -q - r}
+}
+
+// Add returns the sum of the coordinates.
+func (c Cube) Add(v Cube) Cube {
+	return Cube{Q: c.Q + v.Q, R: c.R + v.R, S: c.S + v.S}
+}
+
+// Distance returns the number of moves between the hexes.
+func (c Cube) Distance(to Cube) int {
+	return max(abs(c.Q-to.Q), abs(c.R-to.R), abs(c.S-to.S))
+}
+
+// Global returns the column and row of the hex counted from the top left
+// of the map. It is the inverse of GridHex.Global.
+func (c Cube) Global() (col, row int) {
+	return c.Q, c.R + (c.Q+(c.Q&1))/2
+}
+
+// GridHex returns the hex on the map. It returns false if the hex
+// is off the map.
+func (c Cube) GridHex() (GridHex, bool) {
+	return FromGlobal(c.Global())
+}
+
+// Line returns the hexes on the straight line from c to the hex,
+// including both ends.
+func (c Cube) Line(to Cube) []Cube {
+	n := c.Distance(to)
+	// nudge the ends so points on a side always round the same way
+	aq, ar, as := float64(c.Q)+1e-06, float64(c.R)+2e-06, float64(c.S)-3e-06
+	bq, br, bs := float64(to.Q)+1e-06, float64(to.R)+2e-06, float64(to.S)-3e-06
+	step := 1.0 / math.Max(float64(n), 1.0)
+	var hexes []Cube
+	for i := 0; i <= n; i++ {
+		t := step * float64(i)
+		hexes = append(hexes, cubeRound(aq+(bq-aq)*t, ar+(br-ar)*t, as+(bs-as)*t))
+	}
+	return hexes
+}
+
+// Neighbor returns the hex next to this one in the direction.
+func (c Cube) Neighbor(d Direction) Cube {
+	return c.Add(cubeDirections[d])
+}
+
+// Ring returns the hexes that are radius moves away, starting with the
+// hex to the north and going clockwise. A radius of zero is the hex itself.
+func (c Cube) Ring(radius int) []Cube {
+	if radius <= 0 {
+		return []Cube{c}
+	}
+	var hexes []Cube
+	h := c.Add(cubeDirections[N].scale(radius))
+	for side := 0; side < 6; side++ {
+		for i := 0; i < radius; i++ {
+			hexes = append(hexes, h)
+			h = h.Neighbor(SE.Add(side))
+		}
+	}
+	return hexes
+}
+
+// Subtract returns the vector from the hex to c.
+func (c Cube) Subtract(v Cube) Cube {
+	return Cube{Q: c.Q - v.Q, R: c.R - v.R, S: c.S - v.S}
+}
+
+// cubeRound returns the hex that contains the fractional coordinates.
+func cubeRound(q, r, s float64) Cube {
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+	if dq > dr && dq > ds {
+		rq = -rr - rs
+	} else if dr > ds {
+		rr = -rq - rs
+	} else {
+		rs = -rq - rr
+	}
+	return Cube{Q: int(rq), R: int(rr), S: int(rs)}
+}
+
+// scale returns the vector multiplied by k.
+func (c Cube) scale(k int) Cube {
+	return Cube{Q: c.Q * k, R: c.R * k, S: c.S * k}
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,54 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package coords
+
+// Direction is a direction out of the hex.
+type Direction int
+
+// enums for Direction
+const (
+	N Direction = iota
+	NE
+	SE
+	S
+	SW
+	NW
+)
+
+var directionCodes = []string{N: "N", NE: "NE", SE: "SE", S: "S", SW: "SW", NW: "NW"}
+
+// LookupDirection returns the direction for a code like "N" or "SE".
+// It returns false if the code is not a direction.
+func LookupDirection(s string) (Direction, bool) {
+	for d, code := range directionCodes {
+		if code == s {
+			return Direction(d), true
+		}
+	}
+	return N, false
+}
+
+// Add returns a new direction.
+// When n is positive, it is clockwise.
+// Negative is counter-clockwise.
+func (d Direction) Add(n int) Direction {
+	return Direction(modulo(int(d)+n, 6))
+}
+
+// Subtract returns a new direction.
+// When n is positive, it is counter-clockwise.
+// Negative is clockwise.
+func (d Direction) Subtract(n int) Direction {
+	return Direction(modulo(int(d)-n, 6))
+}
+
+// String implements the fmt.Stringer interface.
+func (d Direction) String() string {
+	return directionCodes[modulo(int(d), 6)]
+}
+
+// modulo is not the remainder ("%") operator!
+func modulo(x, n int) int {
+	return (x%n + n) % n
+}
@@ -0,0 +1,290 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package docconv
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Document is the structure of the main part of a DOCX file.
+type Document struct {
+	Paragraphs []*Paragraph
+}
+
+// Paragraph is a single paragraph from the document.
+// Paragraphs inside a table record the table, row and column of their cell.
+type Paragraph struct {
+	Style     string // paragraph style id, if any
+	PageBreak bool   // true if the paragraph starts on a new page
+	Cell      *Cell  // nil if the paragraph is not in a table
+	Runs      []*Run
+}
+
+// Cell is the location of a table cell.
+// Table, Row, and Col are zero-based.
+type Cell struct {
+	Table int
+	Row   int
+	Col   int
+}
+
+// Run is a span of text that shares the same formatting.
+type Run struct {
+	Text      string
+	Style     string // character style id, if any
+	Bold      bool
+	Italic    bool
+	Underline bool
+}
+
+// Text returns the text of all the runs in the paragraph.
+func (p *Paragraph) Text() string {
+	var sb strings.Builder
+	for _, r := range p.Runs {
+		sb.WriteString(r.Text)
+	}
+	return sb.String()
+}
+
+// ReadDocx reads the main document part of an MS Word docx file.
+// Headers, footers and document properties are ignored.
+func ReadDocx(r io.Reader) (*Document, error) {
+	zipFiles, contentTypeDefinition, err := openDocx(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, override := range contentTypeDefinition.Overrides {
+		if override.ContentType != "application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml" {
+			continue
+		}
+		f, ok := zipFiles[override.PartName]
+		if !ok {
+			return nil, fmt.Errorf("missing '%v' from archive", override.PartName)
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("error opening '%v' from archive: %w", f.Name, err)
+		}
+		defer rc.Close()
+		doc, err := DocxXMLToDocument(rc)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing '%v': %w", f.Name, err)
+		}
+		return doc, nil
+	}
+	return nil, fmt.Errorf("missing main document part")
+}
+
+// DocxXMLToDocument converts Docx XML into paragraphs.
+//
+// A page break inside a paragraph ends the paragraph; the text after
+// the break is placed in a new paragraph that starts on the new page.
+// Line breaks inside a paragraph are kept as new-lines in the run text.
+func DocxXMLToDocument(r io.Reader) (*Document, error) {
+	doc := &Document{}
+
+	// tables can be nested, so we keep a stack of open cells
+	var cells []*Cell
+	tables := 0
+
+	var para *Paragraph
+	var run *Run
+	var pageBreak, inText bool
+	var skip int // depth of skipped elements
+
+	dec := xml.NewDecoder(io.LimitReader(r, maxBytes))
+	for {
+		t, err := dec.Token()
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+
+		switch v := t.(type) {
+		case xml.StartElement:
+			if skip > 0 {
+				skip++
+				continue
+			}
+			switch v.Name.Local {
+			case "instrText", "script", "delText":
+				skip = 1
+			case "tbl":
+				cells = append(cells, &Cell{Table: tables, Row: -1})
+				tables++
+			case "tr":
+				if len(cells) != 0 {
+					cell := cells[len(cells)-1]
+					cell.Row, cell.Col = cell.Row+1, -1
+				}
+			case "tc":
+				if len(cells) != 0 {
+					cells[len(cells)-1].Col++
+				}
+			case "p":
+				para = &Paragraph{PageBreak: pageBreak}
+				pageBreak = false
+				if len(cells) != 0 {
+					cell := *cells[len(cells)-1]
+					para.Cell = &cell
+				}
+				doc.Paragraphs = append(doc.Paragraphs, para)
+			case "pStyle":
+				if para != nil {
+					para.Style = attr(v, "val")
+				}
+			case "pageBreakBefore":
+				if para != nil && isOn(v) {
+					para.PageBreak = true
+				}
+			case "r":
+				run = &Run{}
+				if para != nil {
+					para.Runs = append(para.Runs, run)
+				}
+			case "rStyle":
+				if run != nil {
+					run.Style = attr(v, "val")
+				}
+			case "b":
+				if run != nil {
+					run.Bold = isOn(v)
+				}
+			case "i":
+				if run != nil {
+					run.Italic = isOn(v)
+				}
+			case "u":
+				if run != nil {
+					run.Underline = attr(v, "val") != "none"
+				}
+			case "t":
+				inText = true
+			case "tab":
+				if run != nil {
+					run.Text += "\t"
+				}
+			case "br", "cr":
+				if attr(v, "type") != "page" {
+					if run != nil {
+						run.Text += "\n"
+					}
+				} else if para != nil && para.Text() != "" {
+					// split the paragraph at the page break
+					next := &Paragraph{Style: para.Style, PageBreak: true, Cell: para.Cell}
+					if run != nil {
+						run = &Run{Style: run.Style, Bold: run.Bold, Italic: run.Italic, Underline: run.Underline}
+					} else {
+						run = &Run{}
+					}
+					next.Runs = append(next.Runs, run)
+					doc.Paragraphs = append(doc.Paragraphs, next)
+					para = next
+				} else if para != nil {
+					para.PageBreak = true
+				} else {
+					pageBreak = true
+				}
+			}
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			switch v.Name.Local {
+			case "tbl":
+				if len(cells) != 0 {
+					cells = cells[:len(cells)-1]
+				}
+			case "p":
+				para, run = nil, nil
+			case "r":
+				run = nil
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if skip == 0 && inText && run != nil {
+				run.Text += string(v)
+			}
+		}
+	}
+
+	return doc, nil
+}
+
+// Render returns the text of the document in the form the parsers expect.
+//
+// Each paragraph is written on its own line. A form-feed is written
+// before each paragraph that starts a new page. The cells in a table row
+// are separated by tabs and each row is written on its own line.
+func (d *Document) Render() []byte {
+	var b bytes.Buffer
+	var prev *Paragraph
+	for _, p := range d.Paragraphs {
+		if prev != nil {
+			switch {
+			case prev.Cell == nil || p.Cell == nil:
+				b.WriteByte('\n')
+			case prev.Cell.Table != p.Cell.Table || prev.Cell.Row != p.Cell.Row:
+				b.WriteByte('\n')
+			case prev.Cell.Col != p.Cell.Col:
+				b.WriteByte('\t')
+			default:
+				// another paragraph in the same cell
+				b.WriteByte(' ')
+			}
+		}
+		if p.PageBreak {
+			b.WriteByte('\f')
+		}
+		b.WriteString(p.Text())
+		prev = p
+	}
+	if prev != nil {
+		b.WriteByte('\n')
+	}
+	return b.Bytes()
+}
+
+// Sections splits the document into sections. A new section starts at
+// each paragraph that isHeading returns true for. The paragraphs before
+// the first heading are the first section, if there are any.
+func (d *Document) Sections(isHeading func(p *Paragraph) bool) []*Document {
+	var sections []*Document
+	for _, p := range d.Paragraphs {
+		if len(sections) == 0 || isHeading(p) {
+			sections = append(sections, &Document{})
+		}
+		section := sections[len(sections)-1]
+		section.Paragraphs = append(section.Paragraphs, p)
+	}
+	return sections
+}
+
+// attr returns the value of the named attribute, ignoring the namespace.
+func attr(e xml.StartElement, name string) string {
+	for _, a := range e.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// isOn returns true if a toggle property like <w:b/> is turned on.
+// The property is on unless its value is "0", "false" or "off".
+func isOn(e xml.StartElement) bool {
+	switch attr(e, "val") {
+	case "0", "false", "off":
+		return false
+	}
+	return true
+}
@@ -0,0 +1,242 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package docconv
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// documentXML is a small main document part with the structures
+// that turn reports use.
+const documentXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">
+<w:body>
+<w:p><w:pPr><w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t>Page 1</w:t></w:r></w:p>
+<w:p><w:r><w:rPr><w:b/></w:rPr><w:t>Tribe 0138</w:t></w:r><w:r><w:t xml:space="preserve">, , Current Hex</w:t></w:r></w:p>
+<w:p><w:r><w:rPr><w:i/><w:u w:val="single"/></w:rPr><w:t>Humans</w:t></w:r><w:r><w:tab/><w:t>4</w:t></w:r></w:p>
+<w:p><w:r><w:rPr><w:rStyle w:val="Strong"/><w:b w:val="0"/><w:u w:val="none"/></w:rPr><w:t>Line</w:t><w:br/><w:t>Break</w:t></w:r></w:p>
+<w:p><w:r><w:t>Before</w:t></w:r><w:r><w:br w:type="page"/><w:t>After</w:t></w:r></w:p>
+<w:p><w:pPr><w:pageBreakBefore/></w:pPr><w:r><w:fldChar w:fldCharType="begin"/></w:r><w:r><w:instrText>PAGE</w:instrText></w:r><w:r><w:t>Element 0138e1</w:t></w:r></w:p>
+<w:tbl>
+<w:tr><w:tc><w:p><w:r><w:t>Warriors</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>10</w:t></w:r></w:p><w:p><w:r><w:t>more</w:t></w:r></w:p></w:tc></w:tr>
+<w:tr><w:tc><w:p><w:r><w:t>Horses</w:t></w:r></w:p></w:tc><w:tc><w:p><w:r><w:t>2</w:t></w:r></w:p></w:tc></w:tr>
+</w:tbl>
+<w:p><w:r><w:br w:type="page"/></w:r></w:p>
+<w:p><w:r><w:t>Transfers</w:t></w:r></w:p>
+</w:body>
+</w:document>`
+
+func TestDocxXMLToDocument(t *testing.T) {
+	doc, err := DocxXMLToDocument(strings.NewReader(documentXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		id        int
+		text      string
+		style     string
+		pageBreak bool
+		cell      *Cell
+	}{
+		{1, "Page 1", "Heading1", false, nil},
+		{2, "Tribe 0138, , Current Hex", "", false, nil},
+		{3, "Humans\t4", "", false, nil},
+		{4, "Line\nBreak", "", false, nil},
+		{5, "Before", "", false, nil},
+		{6, "After", "", true, nil},
+		{7, "Element 0138e1", "", true, nil},
+		{8, "Warriors", "", false, &Cell{Table: 0, Row: 0, Col: 0}},
+		{9, "10", "", false, &Cell{Table: 0, Row: 0, Col: 1}},
+		{10, "more", "", false, &Cell{Table: 0, Row: 0, Col: 1}},
+		{11, "Horses", "", false, &Cell{Table: 0, Row: 1, Col: 0}},
+		{12, "2", "", false, &Cell{Table: 0, Row: 1, Col: 1}},
+		{13, "", "", true, nil},
+		{14, "Transfers", "", false, nil},
+	} {
+		if len(doc.Paragraphs) < tc.id {
+			t.Errorf("%d: expected paragraph: got %d paragraphs\n", tc.id, len(doc.Paragraphs))
+			continue
+		}
+		p := doc.Paragraphs[tc.id-1]
+		if got := p.Text(); got != tc.text {
+			t.Errorf("%d: expected text %q: got %q\n", tc.id, tc.text, got)
+		}
+		if p.Style != tc.style {
+			t.Errorf("%d: expected style %q: got %q\n", tc.id, tc.style, p.Style)
+		}
+		if p.PageBreak != tc.pageBreak {
+			t.Errorf("%d: expected page break %v: got %v\n", tc.id, tc.pageBreak, p.PageBreak)
+		}
+		switch {
+		case tc.cell == nil && p.Cell != nil:
+			t.Errorf("%d: expected no cell: got %+v\n", tc.id, *p.Cell)
+		case tc.cell != nil && p.Cell == nil:
+			t.Errorf("%d: expected cell %+v: got nil\n", tc.id, *tc.cell)
+		case tc.cell != nil && *tc.cell != *p.Cell:
+			t.Errorf("%d: expected cell %+v: got %+v\n", tc.id, *tc.cell, *p.Cell)
+		}
+	}
+	if len(doc.Paragraphs) != 14 {
+		t.Errorf("expected 14 paragraphs: got %d\n", len(doc.Paragraphs))
+	}
+}
+
+func TestDocxXMLToDocumentRuns(t *testing.T) {
+	doc, err := DocxXMLToDocument(strings.NewReader(documentXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		id        int
+		para, run int
+		expect    Run
+	}{
+		{1, 1, 0, Run{Text: "Tribe 0138", Bold: true}},
+		{2, 1, 1, Run{Text: ", , Current Hex"}},
+		{3, 2, 0, Run{Text: "Humans", Italic: true, Underline: true}},
+		{4, 2, 1, Run{Text: "\t4"}},
+		{5, 3, 0, Run{Text: "Line\nBreak", Style: "Strong"}},
+	} {
+		runs := doc.Paragraphs[tc.para].Runs
+		if len(runs) <= tc.run {
+			t.Errorf("%d: expected run %d: got %d runs\n", tc.id, tc.run, len(runs))
+			continue
+		}
+		if got := *runs[tc.run]; got != tc.expect {
+			t.Errorf("%d: expected %+v: got %+v\n", tc.id, tc.expect, got)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	doc, err := DocxXMLToDocument(strings.NewReader(documentXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := "Page 1\n" +
+		"Tribe 0138, , Current Hex\n" +
+		"Humans\t4\n" +
+		"Line\nBreak\n" +
+		"Before\n" +
+		"\fAfter\n" +
+		"\fElement 0138e1\n" +
+		"Warriors\t10 more\n" +
+		"Horses\t2\n" +
+		"\f\n" +
+		"Transfers\n"
+	if got := string(doc.Render()); got != expect {
+		t.Errorf("expected %q: got %q\n", expect, got)
+	}
+	if got := string((&Document{}).Render()); got != "" {
+		t.Errorf("expected empty document: got %q\n", got)
+	}
+}
+
+func TestSections(t *testing.T) {
+	doc, err := DocxXMLToDocument(strings.NewReader(documentXML))
+	if err != nil {
+		t.Fatal(err)
+	}
+	isHeading := func(p *Paragraph) bool {
+		text := p.Text()
+		return strings.HasPrefix(text, "Tribe ") || strings.HasPrefix(text, "Element ") || text == "Transfers"
+	}
+	sections := doc.Sections(isHeading)
+	for _, tc := range []struct {
+		id         int
+		first      string
+		paragraphs int
+	}{
+		{1, "Page 1", 1},
+		{2, "Tribe 0138, , Current Hex", 5},
+		{3, "Element 0138e1", 7},
+		{4, "Transfers", 1},
+	} {
+		if len(sections) < tc.id {
+			t.Errorf("%d: expected section: got %d sections\n", tc.id, len(sections))
+			continue
+		}
+		section := sections[tc.id-1]
+		if got := section.Paragraphs[0].Text(); got != tc.first {
+			t.Errorf("%d: expected first paragraph %q: got %q\n", tc.id, tc.first, got)
+		}
+		if got := len(section.Paragraphs); got != tc.paragraphs {
+			t.Errorf("%d: expected %d paragraphs: got %d\n", tc.id, tc.paragraphs, got)
+		}
+	}
+	if len(sections) != 4 {
+		t.Errorf("expected 4 sections: got %d\n", len(sections))
+	}
+	if got := (&Document{}).Sections(isHeading); len(got) != 0 {
+		t.Errorf("expected no sections: got %d\n", len(got))
+	}
+}
+
+// docxFile returns an archive with the parts of a minimal docx file.
+func docxFile(t *testing.T, parts map[string]string) *bytes.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range parts {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return bytes.NewReader(buf.Bytes())
+}
+
+const contentTypesXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>
+</Types>`
+
+func TestReadDocx(t *testing.T) {
+	doc, err := ReadDocx(docxFile(t, map[string]string{
+		"[Content_Types].xml": contentTypesXML,
+		"word/document.xml":   documentXML,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Paragraphs) != 14 {
+		t.Errorf("expected 14 paragraphs: got %d\n", len(doc.Paragraphs))
+	}
+
+	// the same opener is used to convert the file to text
+	text, _, err := ConvertDocx(docxFile(t, map[string]string{
+		"[Content_Types].xml": contentTypesXML,
+		"word/document.xml":   documentXML,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(text, "Tribe 0138, , Current Hex") {
+		t.Errorf("expected text of document: got %q\n", text)
+	}
+
+	for _, tc := range []struct {
+		id    int
+		parts map[string]string
+	}{
+		{1, map[string]string{"word/document.xml": documentXML}},
+		{2, map[string]string{"[Content_Types].xml": contentTypesXML}},
+		{3, map[string]string{"[Content_Types].xml": `<Types></Types>`, "word/document.xml": documentXML}},
+	} {
+		if _, err := ReadDocx(docxFile(t, tc.parts)); err == nil {
+			t.Errorf("%d: expected error: got nil\n", tc.id)
+		}
+	}
+}
@@ -27,38 +27,7 @@ type contentTypeDefinition struct {
 
 // ConvertDocx converts an MS Word docx file to text.
 func ConvertDocx(r io.Reader) (string, map[string]string, error) {
-	var size int64
-
-	// Common case: if the reader is a file (or trivial wrapper), avoid
-	// loading it all into memory.
-	var ra io.ReaderAt
-	if f, ok := r.(interface {
-		io.ReaderAt
-		Stat() (os.FileInfo, error)
-	}); ok {
-		si, err := f.Stat()
-		if err != nil {
-			return "", nil, err
-		}
-		size = si.Size()
-		ra = f
-	} else {
-		b, err := io.ReadAll(io.LimitReader(r, maxBytes))
-		if err != nil {
-			return "", nil, nil
-		}
-		size = int64(len(b))
-		ra = bytes.NewReader(b)
-	}
-
-	zr, err := zip.NewReader(ra, size)
-	if err != nil {
-		return "", nil, fmt.Errorf("error unzipping data: %v", err)
-	}
-
-	zipFiles := mapZipFiles(zr.File)
-
-	contentTypeDefinition, err := getContentTypeDefinition(zipFiles["[Content_Types].xml"])
+	zipFiles, contentTypeDefinition, err := openDocx(r)
 	if err != nil {
 		return "", nil, err
 	}
@@ -115,6 +84,50 @@ func ConvertDocx(r io.Reader) (string, map[string]string, error) {
 	return textHeader + "\n" + textBody + "\n" + textFooter, meta, nil
 }
 
+// openDocx unzips the docx file and returns its parts, along with the
+// content types that say what each part is.
+func openDocx(r io.Reader) (map[string]*zip.File, *contentTypeDefinition, error) {
+	var size int64
+
+	// Common case: if the reader is a file (or trivial wrapper), avoid
+	// loading it all into memory.
+	var ra io.ReaderAt
+	if f, ok := r.(interface {
+		io.ReaderAt
+		Stat() (os.FileInfo, error)
+	}); ok {
+		si, err := f.Stat()
+		if err != nil {
+			return nil, nil, err
+		}
+		size = si.Size()
+		ra = f
+	} else {
+		b, err := io.ReadAll(io.LimitReader(r, maxBytes))
+		if err != nil {
+			return nil, nil, err
+		}
+		size = int64(len(b))
+		ra = bytes.NewReader(b)
+	}
+
+	zr, err := zip.NewReader(ra, size)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error unzipping data: %w", err)
+	}
+	zipFiles := mapZipFiles(zr.File)
+
+	zf, ok := zipFiles["[Content_Types].xml"]
+	if !ok {
+		return nil, nil, fmt.Errorf("missing '[Content_Types].xml' from archive")
+	}
+	contentTypeDefinition, err := getContentTypeDefinition(zf)
+	if err != nil {
+		return nil, nil, err
+	}
+	return zipFiles, contentTypeDefinition, nil
+}
+
 func getContentTypeDefinition(zf *zip.File) (*contentTypeDefinition, error) {
 	f, err := zf.Open()
 	if err != nil {
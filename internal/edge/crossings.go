@@ -0,0 +1,86 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package edge
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Crossings is the extra movement points needed to cross each kind of edge.
+// Edges that can't be crossed cost Impassable. Edges that aren't in the
+// table cost nothing extra.
+type Crossings map[Edge]int
+
+// Impassable is the cost of an edge that can't be crossed.
+const Impassable = -1
+
+// DefaultCrossings is the crossing rules: rivers can only be crossed at a
+// ford, which costs an extra point, and the ocean coast can't be crossed
+// on land.
+func DefaultCrossings() Crossings {
+	return Crossings{
+		OceanCoast: Impassable,
+		River:      Impassable,
+		RiverFord:  1,
+	}
+}
+
+// Cost returns the extra movement points to cross a side of a hex, or false
+// if it can't be crossed. The hexes on either side may report the edge
+// differently; a ford reported by either hex means the river can be crossed.
+// When more than one edge applies, the most expensive one is used.
+func (c Crossings) Cost(edges ...Edge) (int, bool) {
+	ford := false
+	for _, e := range edges {
+		ford = ford || e == RiverFord
+	}
+	cost := 0
+	for _, e := range edges {
+		if e == Unknown || (e == River && ford) {
+			continue
+		}
+		n, ok := c[e]
+		if !ok {
+			continue
+		} else if n < 0 {
+			return 0, false
+		}
+		cost = max(cost, n)
+	}
+	return cost, true
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The table is written as an object keyed by the edge code.
+func (c Crossings) MarshalJSON() ([]byte, error) {
+	m := map[string]int{}
+	for e, n := range c {
+		if e <= Unknown || endOfCodes <= e {
+			return nil, fmt.Errorf("unknown edge \"%d\"", e)
+		}
+		m[Codes[e]] = n
+	}
+	return json.Marshal(m)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Entries are added to the table, replacing any that are already there.
+func (c *Crossings) UnmarshalJSON(b []byte) error {
+	var m map[string]int
+	if err := json.Unmarshal(b, &m); err != nil {
+		return err
+	}
+	if *c == nil {
+		*c = Crossings{}
+	}
+	for code, n := range m {
+		e, ok := Lookup(code)
+		if !ok {
+			return fmt.Errorf("unknown edge %q", code)
+		}
+		(*c)[e] = n
+	}
+	return nil
+}
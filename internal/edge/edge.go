@@ -113,6 +113,13 @@ func (e *Edge) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Lookup returns the edge for a code or long name, like "R" or "River".
+// It returns false if the edge is not known.
+func Lookup(s string) (Edge, bool) {
+	e, _ := unmarshalCode(strings.ToUpper(strings.TrimSpace(s)))
+	return e, e != Unknown
+}
+
 func unmarshalCode(s string) (Edge, bool) {
 	if e, ok := slices.BinarySearch(Codes, s); ok {
 		return Edge(e), true
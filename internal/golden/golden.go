@@ -0,0 +1,95 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+// Package golden implements a test harness that compares results
+// against checked-in "golden" files.
+//
+// Run the parser tests with the -update flag to regenerate the golden files:
+//
+//	go test ./internal/turnrpt ./internal/parsers/... ./internal/adapters -update
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// Corpus returns the paths to the sample turn reports in the directory.
+// It fails the test if there are no reports.
+func Corpus(t *testing.T, dir string) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		t.Fatalf("corpus: %v\n", err)
+	} else if len(files) == 0 {
+		t.Fatalf("corpus: %s: no turn reports found\n", dir)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// Name returns the name of the report without the directory or extension.
+// It is used to name the sub-test and the golden file.
+func Name(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// Compare checks the value against the golden file.
+// The value is converted to indented JSON before comparing.
+// If the -update flag is set, the golden file is written instead.
+func Compare(t *testing.T, path string, v any) {
+	t.Helper()
+
+	got, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		t.Fatalf("golden: %s: %v\n", path, err)
+	}
+	got = append(got, '\n')
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("golden: %v\n", err)
+		} else if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("golden: %v\n", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden: %v (run with -update to create it)\n", err)
+	}
+	if line, w, g, ok := diff(want, got); !ok {
+		t.Errorf("golden: %s:%d:\n\twant %s\n\t got %s\n", path, line, w, g)
+	}
+}
+
+// diff returns the first line that differs between want and got.
+// It returns true if they are the same.
+func diff(want, got []byte) (line int, w, g string, ok bool) {
+	if bytes.Equal(want, got) {
+		return 0, "", "", true
+	}
+	wl, gl := strings.Split(string(want), "\n"), strings.Split(string(got), "\n")
+	for line = 0; line < len(wl) || line < len(gl); line++ {
+		w, g = "<eof>", "<eof>"
+		if line < len(wl) {
+			w = wl[line]
+		}
+		if line < len(gl) {
+			g = gl[line]
+		}
+		if w != g {
+			break
+		}
+	}
+	return line + 1, w, g, false
+}
@@ -0,0 +1,144 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package grids
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/coords"
+	"github.com/mdhender/chief/internal/model"
+	"sort"
+)
+
+// Key returns the solver key for the hex a unit started (or ended) a turn in.
+func Key(turn, unit string, end bool) string {
+	if end {
+		return turn + "/" + unit + "/end"
+	}
+	return turn + "/" + unit + "/start"
+}
+
+// FromReports returns a solver with the anchors and links from the reports.
+// Every hex with a grid is an anchor. Links that contradict the anchors or
+// earlier links are ignored and returned as errors.
+func FromReports(reports []*model.Report) (*Solver, []error) {
+	s := New()
+	var errs []error
+
+	// turns must be linked in order
+	reports = append([]*model.Report{}, reports...)
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Turn < reports[j].Turn
+	})
+
+	// lastSeen is the key and hex for the end of the latest turn for each unit
+	type seen struct {
+		key string
+		hex model.Hex
+	}
+	lastSeen := map[string]seen{}
+
+	for _, r := range reports {
+		var ids []string
+		for id := range r.Units {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		for _, id := range ids {
+			u := r.Units[id]
+			if u.Location == nil {
+				continue
+			}
+			start, end := Key(r.Turn, id, false), Key(r.Turn, id, true)
+			for _, h := range []struct {
+				key string
+				hex model.Hex
+			}{{start, u.Location.StartedIn}, {end, u.Location.Current}} {
+				if h.hex.HasGrid() {
+					if err := s.Anchor(h.key, h.hex.Grid); err != nil {
+						errs = append(errs, err)
+					}
+				}
+			}
+
+			// the unit starts the turn where it ended the last turn
+			if last, ok := lastSeen[id]; ok && sameLocal(last.hex, u.Location.StartedIn) {
+				if err := s.Link(last.key, start, Offset{}); err != nil {
+					errs = append(errs, err)
+				}
+			}
+			if !u.Location.Current.IsZero() {
+				lastSeen[id] = seen{key: end, hex: u.Location.Current}
+			}
+
+			if u.Follows != "" {
+				// the unit ends the turn with the unit it follows
+				if leader, ok := r.Units[u.Follows]; ok && leader.Location != nil && sameLocal(leader.Location.Current, u.Location.Current) {
+					if err := s.Link(Key(r.Turn, u.Follows, true), end, Offset{}); err != nil {
+						errs = append(errs, err)
+					}
+				}
+			} else if !u.Location.StartedIn.IsZero() && !u.Location.Current.IsZero() {
+				col, row, off := Walk(u.Location.StartedIn, u.Movement)
+				if col != u.Location.Current.Col || row != u.Location.Current.Row {
+					errs = append(errs, fmt.Errorf("unit %s: turn %s: moves from %s end in %02d%02d, not %s", id, r.Turn, u.Location.StartedIn, col, row, u.Location.Current))
+				} else if err := s.Link(start, end, off); err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+
+	return s, errs
+}
+
+// Hex returns the hex with the grid resolved. It returns false if
+// the grid is hidden and the solver can't resolve it.
+func (s *Solver) Hex(key string, h model.Hex) (model.Hex, bool) {
+	if h.IsZero() || h.HasGrid() {
+		return h, true
+	}
+	grid, ok := s.Grid(key)
+	if !ok {
+		return h, false
+	}
+	return h.WithGrid(grid), true
+}
+
+// Walk follows the successful moves from the hex without knowing its grid.
+// It returns the column and row of the hex the moves end in and the number
+// of grids crossed to get there.
+//
+// The moves are followed as if the hex were in the top left grid, and the
+// columns and rows past the edge of that grid are counted as grids crossed.
+func Walk(from model.Hex, moves []*model.Movement) (col, row int, off Offset) {
+	c := coords.GridHex{Grid: "AA", Col: from.Col, Row: from.Row}.Cube()
+	for _, move := range moves {
+		if move.Result != nil && move.Result.Failed != nil {
+			continue
+		} else if d, ok := coords.LookupDirection(move.Direction); ok {
+			c = c.Neighbor(d)
+		}
+	}
+
+	// convert back to a column and row in a grid
+	col, row = c.Global()
+	off.Cols, col = floorDiv(col-1, coords.Columns), modulo(col-1, coords.Columns)+1
+	off.Rows, row = floorDiv(row-1, coords.Rows), modulo(row-1, coords.Rows)+1
+	return col, row, off
+}
+
+// sameLocal returns true if the hexes have the same column and row.
+func sameLocal(a, b model.Hex) bool {
+	return !a.IsZero() && a.Col == b.Col && a.Row == b.Row
+}
+
+func floorDiv(x, n int) int {
+	return (x - modulo(x, n)) / n
+}
+
+// modulo is not the remainder ("%") operator!
+func modulo(x, n int) int {
+	return (x%n + n) % n
+}
@@ -0,0 +1,137 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+// Package grids resolves the hidden "##" grid in hex ids.
+//
+// Turn reports often hide the grid of a hex. The hidden grid is the
+// same for every hex in a report that is in the same grid, so a single
+// hex with a known grid (an anchor) reveals the grid of every hex that
+// is linked to it. Hexes are linked by movement (a unit's starting and
+// ending hexes), by following, and by turns (a unit ends one turn in the
+// hex it starts the next turn in).
+//
+// The solver is a union-find where each link records the difference
+// in grids between two hexes, since a move can cross into the next grid.
+package grids
+
+import (
+	"fmt"
+)
+
+// Offset is the difference between two grids, in grids.
+// Columns are the second letter of the grid and rows the first.
+type Offset struct {
+	Cols, Rows int
+}
+
+// Solver finds the grids of hexes from anchors and links.
+type Solver struct {
+	// parent is the union-find forest. offset is the grid of the key
+	// minus the grid of its parent.
+	parent map[string]string
+	offset map[string]Offset
+	// anchors is the known grid of the root of a set, in grid coordinates.
+	anchors map[string]Offset
+}
+
+// New returns an empty solver.
+func New() *Solver {
+	return &Solver{
+		parent:  make(map[string]string),
+		offset:  make(map[string]Offset),
+		anchors: make(map[string]Offset),
+	}
+}
+
+// Anchor records that the hex for the key is in the grid, like "AB".
+// It returns an error if the grid is not valid or if it contradicts
+// what is already known.
+func (s *Solver) Anchor(key, grid string) error {
+	g, ok := parseGrid(grid)
+	if !ok {
+		return fmt.Errorf("anchor %s: invalid grid %q", key, grid)
+	}
+	root, off := s.find(key)
+	// the grid of the root is the grid of the key minus its offset
+	g = Offset{Cols: g.Cols - off.Cols, Rows: g.Rows - off.Rows}
+	if known, ok := s.anchors[root]; ok && known != g {
+		return fmt.Errorf("anchor %s: grid %s conflicts with %s", key, grid, formatGrid(Offset{Cols: known.Cols + off.Cols, Rows: known.Rows + off.Rows}))
+	}
+	s.anchors[root] = g
+	return nil
+}
+
+// Link records that the grid of b is the grid of a plus the offset.
+// It returns an error if the link contradicts what is already known.
+func (s *Solver) Link(a, b string, off Offset) error {
+	ra, oa := s.find(a)
+	rb, ob := s.find(b)
+	if ra == rb {
+		// grid(b) - grid(a) must equal the offset
+		if ob.Cols-oa.Cols != off.Cols || ob.Rows-oa.Rows != off.Rows {
+			return fmt.Errorf("link %s %s: offset %v conflicts with %v", a, b, off, Offset{Cols: ob.Cols - oa.Cols, Rows: ob.Rows - oa.Rows})
+		}
+		return nil
+	}
+
+	// attach rb under ra: grid(rb) = grid(b) - ob = grid(a) + off - ob = grid(ra) + oa + off - ob
+	delta := Offset{Cols: oa.Cols + off.Cols - ob.Cols, Rows: oa.Rows + off.Rows - ob.Rows}
+	if ga, ok := s.anchors[ra]; ok {
+		if gb, ok := s.anchors[rb]; ok && (gb.Cols != ga.Cols+delta.Cols || gb.Rows != ga.Rows+delta.Rows) {
+			return fmt.Errorf("link %s %s: grids %s and %s conflict", a, b, formatGrid(ga), formatGrid(gb))
+		}
+	} else if gb, ok := s.anchors[rb]; ok {
+		s.anchors[ra] = Offset{Cols: gb.Cols - delta.Cols, Rows: gb.Rows - delta.Rows}
+	}
+	delete(s.anchors, rb)
+	s.parent[rb], s.offset[rb] = ra, delta
+	return nil
+}
+
+// Grid returns the grid of the hex for the key, like "AB".
+// It returns false if the grid can't be resolved.
+func (s *Solver) Grid(key string) (string, bool) {
+	root, off := s.find(key)
+	g, ok := s.anchors[root]
+	if !ok {
+		return "", false
+	}
+	g = Offset{Cols: g.Cols + off.Cols, Rows: g.Rows + off.Rows}
+	if g.Cols < 0 || g.Cols > 25 || g.Rows < 0 || g.Rows > 25 {
+		return "", false
+	}
+	return formatGrid(g), true
+}
+
+// find returns the root of the key and the grid of the key minus the
+// grid of the root. It compresses the path as it goes.
+func (s *Solver) find(key string) (string, Offset) {
+	parent, ok := s.parent[key]
+	if !ok {
+		s.parent[key] = key
+		return key, Offset{}
+	} else if parent == key {
+		return key, Offset{}
+	}
+	root, po := s.find(parent)
+	off := s.offset[key]
+	off = Offset{Cols: off.Cols + po.Cols, Rows: off.Rows + po.Rows}
+	s.parent[key], s.offset[key] = root, off
+	return root, off
+}
+
+// parseGrid converts a grid like "AB" to grid coordinates.
+func parseGrid(grid string) (Offset, bool) {
+	if len(grid) != 2 || grid[0] < 'A' || grid[0] > 'Z' || grid[1] < 'A' || grid[1] > 'Z' {
+		return Offset{}, false
+	}
+	return Offset{Cols: int(grid[1] - 'A'), Rows: int(grid[0] - 'A')}, true
+}
+
+// formatGrid converts grid coordinates to a grid like "AB".
+func formatGrid(g Offset) string {
+	if g.Cols < 0 || g.Cols > 25 || g.Rows < 0 || g.Rows > 25 {
+		return fmt.Sprintf("(%d, %d)", g.Cols, g.Rows)
+	}
+	return string([]byte{byte('A' + g.Rows), byte('A' + g.Cols)})
+}
@@ -0,0 +1,82 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package grids
+
+import (
+	"github.com/mdhender/chief/internal/model"
+	"strings"
+	"testing"
+)
+
+func TestSolver(t *testing.T) {
+	s := New()
+	if err := s.Link("a", "b", Offset{Cols: 1}); err != nil {
+		t.Fatalf("link a b: %v\n", err)
+	} else if err := s.Link("c", "b", Offset{Rows: 1}); err != nil {
+		t.Fatalf("link c b: %v\n", err)
+	} else if _, ok := s.Grid("a"); ok {
+		t.Errorf("grid a: want unresolved before the anchor\n")
+	}
+	if err := s.Anchor("c", "BB"); err != nil {
+		t.Fatalf("anchor c: %v\n", err)
+	}
+	for _, tc := range []struct {
+		id     int
+		key    string
+		expect string
+		ok     bool
+	}{
+		{1, "a", "CA", true},
+		{2, "b", "CB", true},
+		{3, "c", "BB", true},
+		{4, "d", "", false},
+	} {
+		got, ok := s.Grid(tc.key)
+		if ok != tc.ok || got != tc.expect {
+			t.Errorf("%d: grid %s: want %q %v, got %q %v\n", tc.id, tc.key, tc.expect, tc.ok, got, ok)
+		}
+	}
+	if err := s.Anchor("a", "AA"); err == nil {
+		t.Errorf("anchor a: want conflict, got nil\n")
+	} else if err := s.Link("a", "c", Offset{}); err == nil {
+		t.Errorf("link a c: want conflict, got nil\n")
+	}
+}
+
+func TestWalk(t *testing.T) {
+	for _, tc := range []struct {
+		id       int
+		from     string
+		moves    string
+		col, row int
+		off      Offset
+	}{
+		{1, "## 0202", "N", 2, 1, Offset{}},
+		{2, "## 0201", "N", 2, 21, Offset{Rows: -1}},
+		{3, "## 3010", "SE", 1, 11, Offset{Cols: 1}},
+		{4, "## 0101", "NW", 30, 21, Offset{Cols: -1, Rows: -1}},
+		{5, "## 0607", "SE SE", 8, 8, Offset{}},
+	} {
+		from, err := model.ParseHex(tc.from)
+		if err != nil {
+			t.Fatalf("%d: %v\n", tc.id, err)
+		}
+		var moves []*model.Movement
+		for _, d := range strings.Fields(tc.moves) {
+			moves = append(moves, &model.Movement{Direction: d})
+		}
+		col, row, off := Walk(from, moves)
+		if col != tc.col || row != tc.row || off != tc.off {
+			t.Errorf("%d: %s %s: want %02d%02d %v, got %02d%02d %v\n", tc.id, tc.from, tc.moves, tc.col, tc.row, tc.off, col, row, off)
+		}
+		// the walk must agree with the neighbors when the grid is known
+		h := from.WithGrid("MM")
+		for _, m := range moves {
+			h, _ = h.Neighbor(m.Direction)
+		}
+		if h.Col != col || h.Row != row || h.Grid != formatGrid(Offset{Cols: 12 + off.Cols, Rows: 12 + off.Rows}) {
+			t.Errorf("%d: %s %s: neighbor: got %s\n", tc.id, tc.from, tc.moves, h)
+		}
+	}
+}
@@ -1,11 +0,0 @@
-// chief - a TribeNet player aid
-// Copyright (c) 2022-2023 Michael D Henderson. All rights reserved.
-
-package hexes
-
-type HEXLAYOUT int
-
-const (
-	FLATHEX   HEXLAYOUT = 0
-	POINTYHEX HEXLAYOUT = 1
-)
@@ -1,17 +0,0 @@
-// chief - a TribeNet player aid
-// Copyright (c) 2022-2023 Michael D Henderson. All rights reserved.
-
-package hexes
-
-// Point is either a hex or screen coordinate
-type Point struct {
-	x, y float64
-}
-
-func NewPoint(x, y float64) Point {
-	return Point{x: x, y: y}
-}
-
-func (p Point) Coords() (x, y float64) {
-	return p.x, p.y
-}
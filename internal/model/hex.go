@@ -0,0 +1,18 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package model
+
+import (
+	"github.com/mdhender/chief/internal/coords"
+)
+
+// Hex is a location on the TribeNet map, like "AB 0102".
+// It is the coords.GridHex; see that package for the details.
+type Hex = coords.GridHex
+
+// ParseHex parses a hex like "AB 0102" or "## 0102".
+// The empty string and "N/A" parse as the zero Hex.
+func ParseHex(s string) (Hex, error) {
+	return coords.Parse(s)
+}
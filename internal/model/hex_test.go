@@ -0,0 +1,74 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package model
+
+import (
+	"testing"
+)
+
+func TestParseHex(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect Hex
+		ok     bool
+	}{
+		{1, "AB 0102", Hex{Grid: "AB", Col: 1, Row: 2}, true},
+		{2, "## 3021", Hex{Grid: "##", Col: 30, Row: 21}, true},
+		{3, "", Hex{}, true},
+		{4, "N/A", Hex{}, true},
+		{5, "AB 0002", Hex{}, false},
+		{6, "AB 3101", Hex{}, false},
+		{7, "AB 0122", Hex{}, false},
+		{8, "ab 0101", Hex{}, false},
+		{9, "AB0101", Hex{}, false},
+	} {
+		got, err := ParseHex(tc.input)
+		if tc.ok && err != nil {
+			t.Errorf("%d: %q: want ok, got %v\n", tc.id, tc.input, err)
+		} else if !tc.ok && err == nil {
+			t.Errorf("%d: %q: want error, got nil\n", tc.id, tc.input)
+		} else if got != tc.expect {
+			t.Errorf("%d: %q: want %+v, got %+v\n", tc.id, tc.input, tc.expect, got)
+		} else if tc.ok && got.String() != tc.input && tc.input != "N/A" {
+			t.Errorf("%d: %q: string: got %q\n", tc.id, tc.input, got.String())
+		}
+	}
+}
+
+func TestNeighbor(t *testing.T) {
+	for _, tc := range []struct {
+		id        int
+		from      string
+		direction string
+		expect    string
+		ok        bool
+	}{
+		{1, "AA 0202", "N", "AA 0201", true},
+		{2, "AA 0202", "NE", "AA 0302", true},
+		{3, "AA 0202", "SE", "AA 0303", true},
+		{4, "AA 0202", "SW", "AA 0103", true},
+		{5, "AA 0302", "NE", "AA 0401", true},
+		{6, "AA 0302", "SE", "AA 0402", true},
+		{7, "AA 0302", "NW", "AA 0201", true},
+		{8, "AA 3010", "SE", "AB 0111", true},
+		{9, "AA 0521", "S", "BA 0501", true},
+		{10, "BB 0101", "NW", "AA 3021", true},
+		{11, "AA 0101", "N", "", false},
+		{12, "## 0521", "S", "", false},
+		{13, "## 0809", "S", "## 0810", true},
+		{14, "AA 0202", "X", "", false},
+	} {
+		from, err := ParseHex(tc.from)
+		if err != nil {
+			t.Fatalf("%d: %v\n", tc.id, err)
+		}
+		got, ok := from.Neighbor(tc.direction)
+		if ok != tc.ok {
+			t.Errorf("%d: %s %s: want ok %v, got %v\n", tc.id, tc.from, tc.direction, tc.ok, ok)
+		} else if got.String() != tc.expect {
+			t.Errorf("%d: %s %s: want %q, got %q\n", tc.id, tc.from, tc.direction, tc.expect, got.String())
+		}
+	}
+}
@@ -0,0 +1,173 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+// Package model defines the turn report data that is shared by the parsers,
+// the mapper and the web server.
+//
+// The JSON for Report is the same as the scouting results files.
+package model
+
+import (
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/terrain"
+)
+
+// Report is the data from one clan's turn report.
+type Report struct {
+	FileName string `json:"file-name,omitempty"`
+	Clan     string `json:"clan,omitempty"`
+	Turn     string `json:"turn,omitempty"`
+	// Units is a map of unit id (e.g. 1138c1) to Unit data
+	Units map[string]*Unit `json:"units,omitempty"`
+	// Transfers is the list of goods moved between units
+	Transfers []*Transfer `json:"transfers,omitempty"`
+	// Settlements is the list at the end of the report
+	Settlements []*Settlement `json:"settlements,omitempty"`
+}
+
+// Unit is data for the unit moving or scouting.
+type Unit struct {
+	Id string `json:"id,omitempty"`
+	// Kind is tribe, clan, element, or courier
+	Kind string `json:"kind,omitempty"`
+	// Notes are GM or player notes for the unit
+	Notes []*Note `json:"notes,omitempty"`
+	// Location is where the unit started and finished the turn
+	Location *UnitLocation `json:"location,omitempty"`
+	// Follows is set only if the unit is following another.
+	Follows string `json:"follows,omitempty"`
+	// Movement is a slice of the unit's movements.
+	Movement []*Movement `json:"movement,omitempty"`
+	// Scouts is a slice of the tribe's scouts.
+	Scouts map[string]*Scout `json:"scouts,omitempty"`
+	// Check is a sanity check from translating the report to json.
+	Check *Check `json:"check,omitempty"`
+	// People is the number of people in the unit.
+	People *People `json:"people,omitempty"`
+	// Inventory is the unit's possessions.
+	Inventory *Inventory `json:"inventory,omitempty"`
+	// Skills is a map of skill code to level.
+	Skills Skills `json:"skills,omitempty"`
+	// Morale and Weight are from the end of the unit report.
+	Morale float64 `json:"morale,omitempty"`
+	Weight int     `json:"weight,omitempty"`
+}
+
+// Note is free-text for a hex.
+type Note struct {
+	Hex  Hex    `json:"hex,omitzero"`
+	Text string `json:"text,omitempty"`
+}
+
+// UnitLocation tracks the starting and ending hexes for a unit.
+type UnitLocation struct {
+	// Current is the Hex the unit ends the turn in
+	Current Hex `json:"current,omitzero"`
+	// StartedIn is the Hex the unit starts the turn in
+	StartedIn Hex `json:"previous,omitzero"`
+}
+
+// Movement is a unit's movement, or a scout's "scout" action.
+type Movement struct {
+	Direction string          `json:"direction,omitempty"`
+	Result    *MovementResult `json:"result,omitempty"`
+}
+
+// MovementResult is the result of an attempted move.
+// It may fail or succeed.
+type MovementResult struct {
+	// From is the hex the movement started in.
+	From Hex `json:"from,omitzero"`
+	// Failed is set only if the movement failed.
+	Failed *MovementFailure `json:"failed,omitempty"`
+	// To is the hex the movement ended in.
+	// If the movement failed, then From and To will be the same value.
+	To Hex `json:"to,omitzero"`
+	// Terrain is set only if the move succeeded.
+	// It is the terrain of the hex entered.
+	Terrain terrain.Terrain `json:"terrain,omitempty"`
+	// Edges defines the terrain or feature that can be seen in a given direction.
+	// It is always relative to the hex the movement ended in.
+	Edges map[string]*edge.Edge `json:"edges,omitempty"`
+	// Found is a list of things the scouting party found.
+	// If the movement succeeded, these will be in the To hex.
+	// Otherwise, they are in the From hex.
+	Found []string `json:"found,omitempty"`
+}
+
+// MovementFailure represents why the movement attempt failed.
+type MovementFailure struct {
+	NoFord      bool `json:"no-ford,omitempty"`
+	NotEnoughMp bool `json:"not-enough-mp"`
+	OceanCoast  bool `json:"ocean-coast,omitempty"`
+}
+
+// Scout is a single scouting party.
+type Scout struct {
+	Id string `json:"-"`
+	// Scout is the "scout" action
+	Scout []*Movement `json:"scout,omitempty"`
+}
+
+// Check is a sanity check.
+type Check struct {
+	Hex     Hex                   `json:"hex,omitzero"`
+	Terrain terrain.Terrain       `json:"terrain,omitempty"`
+	Edges   map[string]*edge.Edge `json:"edges,omitempty"`
+	// Found is a list of things found in the hex.
+	Found []string `json:"found,omitempty"`
+}
+
+// People is the number of people in a unit, by type.
+type People struct {
+	Warriors int `json:"warriors,omitempty"`
+	Active   int `json:"active,omitempty"`
+	Inactive int `json:"inactive,omitempty"`
+}
+
+// Total returns the number of people in the unit.
+func (p *People) Total() int {
+	if p == nil {
+		return 0
+	}
+	return p.Warriors + p.Active + p.Inactive
+}
+
+// Inventory is a unit's possessions.
+// Each category is a map of item name to quantity.
+type Inventory struct {
+	Animals       map[string]int `json:"animals,omitempty"`
+	Minerals      map[string]int `json:"minerals,omitempty"`
+	WarEquipment  map[string]int `json:"war-equipment,omitempty"`
+	FinishedGoods map[string]int `json:"finished-goods,omitempty"`
+	RawMaterials  map[string]int `json:"raw-materials,omitempty"`
+	Ships         map[string]int `json:"ships,omitempty"`
+}
+
+// Skills is a map of skill code (e.g. "Adm") to level.
+type Skills map[string]int
+
+// Transfer is an item moved from one unit to another.
+type Transfer struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Item     string `json:"item"`
+	Quantity int    `json:"quantity"`
+}
+
+// Settlement is a settlement from the end of the report.
+type Settlement struct {
+	Hex     Hex    `json:"hex,omitzero"`
+	Name    string `json:"name,omitempty"`
+	Note    string `json:"note,omitempty"`
+	Type    string `json:"type,omitempty"`
+	SubType string `json:"sub-type,omitempty"`
+	// Unit is the unit that was in the hex, or the clan if none was.
+	Unit string `json:"unit,omitempty"`
+	// UnitInferred is set when Unit is a unit. The report doesn't say
+	// which unit saw the settlement, so it is a guess.
+	UnitInferred bool `json:"unit-inferred,omitempty"`
+	// Started is set when the unit started the turn in the hex,
+	// rather than ended it there.
+	Started bool `json:"started,omitempty"`
+}
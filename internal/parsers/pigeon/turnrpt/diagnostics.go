@@ -0,0 +1,214 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package parser
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Diagnostic describes a problem found while parsing a turn report.
+// Line and Column are 1-based and refer to the input given to the parser.
+type Diagnostic struct {
+	File     string   `json:"file,omitempty"`
+	Line     int      `json:"line"`
+	Column   int      `json:"column"`
+	Offset   int      `json:"offset"`
+	Unit     string   `json:"unit,omitempty"`
+	Section  string   `json:"section,omitempty"`
+	Expected []string `json:"expected,omitempty"`
+	Message  string   `json:"message"`
+	Excerpt  string   `json:"excerpt,omitempty"`
+
+	length int // length of the unit report for placeholders
+}
+
+// Error implements the error interface.
+func (d *Diagnostic) Error() string {
+	var sb strings.Builder
+	if d.File != "" {
+		sb.WriteString(d.File)
+		sb.WriteByte(':')
+	}
+	sb.WriteString(fmt.Sprintf("%d:%d:", d.Line, d.Column))
+	if d.Unit != "" {
+		sb.WriteString(" unit " + d.Unit + ":")
+	}
+	if d.Section != "" {
+		sb.WriteString(" " + d.Section + ":")
+	}
+	sb.WriteString(" " + d.Message)
+	if d.Excerpt != "" {
+		sb.WriteString("\n" + d.Excerpt)
+	}
+	return sb.String()
+}
+
+// Diagnose returns diagnostics for the result of parsing the input.
+// The rpt and err arguments are the values returned by Parse.
+//
+// Errors recorded by the parser are converted to diagnostics.
+// Diagnostics recorded on unit reports that failed to parse are included.
+// If the parser stopped before the end of the input, the unconsumed
+// input is parsed again as a unit report to find the farthest
+// position reached and the alternatives that were expected there.
+func Diagnose(filename string, input []byte, rpt *Report, err error) []*Diagnostic {
+	var diags []*Diagnostic
+
+	var list errList
+	if err != nil && !errors.As(err, &list) {
+		list = errList{err}
+	}
+	for _, e := range list {
+		var pe *parserError
+		if errors.As(e, &pe) {
+			diags = append(diags, NewDiagnostic(filename, input, pe.pos.offset, pe.expected, pe.Inner.Error()))
+		} else {
+			diags = append(diags, NewDiagnostic(filename, input, 0, nil, e.Error()))
+		}
+	}
+
+	if rpt == nil {
+		return diags
+	}
+	for _, t := range rpt.T {
+		for _, e := range t.Errors {
+			var d *Diagnostic
+			if errors.As(e, &d) {
+				diagnoseUnit(d, filename, input)
+				diags = append(diags, d)
+			}
+		}
+	}
+	sort.Slice(diags, func(i, j int) bool {
+		return diags[i].Offset < diags[j].Offset
+	})
+	if strings.TrimSpace(rpt.Rest) == "" {
+		return diags
+	}
+
+	// the rest is always a suffix of the input
+	offset := len(input) - len(rpt.Rest)
+	_, err = Parse(filename, input[offset:], Entrypoint("UnitReport"))
+	var diag *Diagnostic
+	if errors.As(err, &list) {
+		for _, e := range list {
+			var pe *parserError
+			if errors.As(e, &pe) && len(pe.expected) != 0 {
+				diag = NewDiagnostic(filename, input, offset+pe.pos.offset, pe.expected, "unexpected input")
+				break
+			}
+		}
+	}
+	if diag == nil {
+		diag = NewDiagnostic(filename, input, offset, nil, "unexpected input")
+	}
+	return append(diags, diag)
+}
+
+// NewDiagnostic returns a diagnostic for the byte offset in the input.
+// It finds the unit and section that contain the offset and creates
+// an excerpt of the line with a caret under the offset.
+func NewDiagnostic(filename string, input []byte, offset int, expected []string, message string) *Diagnostic {
+	if offset < 0 {
+		offset = 0
+	} else if offset > len(input) {
+		offset = len(input)
+	}
+
+	d := &Diagnostic{
+		File:     filename,
+		Line:     1 + bytes.Count(input[:offset], []byte{'\n'}),
+		Offset:   offset,
+		Expected: expected,
+		Message:  message,
+	}
+	if len(expected) != 0 {
+		d.Message = fmt.Sprintf("%s, expected %s", message, listJoin(expected, ", ", "or"))
+	}
+
+	// find the line containing the offset
+	bol := bytes.LastIndexByte(input[:offset], '\n') + 1
+	eol := bytes.IndexByte(input[offset:], '\n')
+	if eol == -1 {
+		eol = len(input)
+	} else {
+		eol += offset
+	}
+	d.Column = 1 + utf8.RuneCount(input[bol:offset])
+
+	// the caret lines up with the offset as long as we keep the tabs
+	caret := []byte(strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+		return ' '
+	}, string(input[bol:offset])))
+	d.Excerpt = fmt.Sprintf("%s\n%s^", bytes.TrimRight(input[bol:eol], "\r"), caret)
+
+	// the unit and section are from the last headings at or before the offset
+	for _, line := range bytes.Split(input[:eol], []byte{'\n'}) {
+		line = bytes.TrimLeft(line, "\f")
+		if m := reDiagUnit.FindSubmatch(line); m != nil {
+			d.Unit, d.Section = string(m[1]), "Unit Heading"
+		} else if m := reDiagSection.FindSubmatch(line); m != nil {
+			d.Section = string(m[1])
+			if d.Section == "Transfers" || d.Section == "Settlements" {
+				d.Unit = ""
+			}
+		}
+	}
+
+	return d
+}
+
+var (
+	// reDiagUnit matches a unit heading and captures the unit id.
+	reDiagUnit = regexp.MustCompile(`^(?:Tribe|Courier|Element) (\d{4}(?:[ce]\d)?),`)
+	// reDiagSection matches a section heading and captures the name of the section.
+	reDiagSection = regexp.MustCompile(`^(Current Turn|Goods Tribe|Desired Commodities|Tribe Activities|Final Activities|Tribe Movement|Tribe Follows|Scout \d|\d{4}(?:[ce]\d)? Status|Humans|Animals|Minerals|War Equipment|Finished Goods|Raw Materials|Ships|Skills|Morale|Weight|Truces|Transfers|Settlements)\b`)
+)
+
+// badUnit returns a placeholder diagnostic for a unit report that failed
+// to parse. The text is the unit report and pos is where it starts in the
+// input. Diagnose replaces the placeholder with the details of the failure;
+// the actions can't do that because they can't call Parse.
+func badUnit(id string, text []byte, pos position) *Diagnostic {
+	return &Diagnostic{
+		Line:    pos.line,
+		Column:  pos.col,
+		Offset:  pos.offset,
+		Unit:    id,
+		Section: "Unit Heading",
+		Message: "invalid unit report",
+		length:  len(text),
+	}
+}
+
+// diagnoseUnit parses the unit report again to find where it failed
+// and updates the diagnostic.
+func diagnoseUnit(d *Diagnostic, filename string, input []byte) {
+	d.File = filename
+	if d.length == 0 || d.Offset+d.length > len(input) {
+		return
+	}
+	text := input[d.Offset : d.Offset+d.length]
+	_, err := Parse(filename, text, Entrypoint("UnitReport"))
+	var list errList
+	if errors.As(err, &list) {
+		for _, e := range list {
+			var pe *parserError
+			if errors.As(e, &pe) && len(pe.expected) != 0 {
+				*d = *NewDiagnostic(filename, input, d.Offset+pe.pos.offset, pe.expected, "unexpected input")
+				return
+			}
+		}
+	}
+	d.length = 0
+}
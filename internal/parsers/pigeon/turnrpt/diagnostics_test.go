@@ -0,0 +1,177 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewDiagnostic(t *testing.T) {
+	input := []byte("Tribe 0138, , Current Hex = AA 0101\n" +
+		"Current Turn 900-01 (#1)\n" +
+		"Humans\n\tPeople\tWarriors\n\t1\t2\n" +
+		"\fElement 0138e1, , Current Hex = AA 0102\n" +
+		"Tribe Movement: Move N\n" +
+		"\fTransfers\n" +
+		"0138 to 0138e1")
+
+	for _, tc := range []struct {
+		id      int
+		offset  int
+		line    int
+		column  int
+		unit    string
+		section string
+		excerpt string
+	}{
+		{1, 0, 1, 1, "0138", "Unit Heading", "Tribe 0138, , Current Hex = AA 0101\n^"},
+		{2, -5, 1, 1, "0138", "Unit Heading", "Tribe 0138, , Current Hex = AA 0101\n^"},
+		{3, strings.Index(string(input), "900-01"), 2, 14, "0138", "Current Turn", "Current Turn 900-01 (#1)\n             ^"},
+		{4, strings.Index(string(input), "Warriors"), 4, 9, "0138", "Humans", "\tPeople\tWarriors\n\t      \t^"},
+		{5, strings.Index(string(input), "0138e1,"), 6, 10, "0138e1", "Unit Heading", "\fElement 0138e1, , Current Hex = AA 0102\n         ^"},
+		{6, strings.Index(string(input), "N\n"), 7, 22, "0138e1", "Tribe Movement", "Tribe Movement: Move N\n                     ^"},
+		{7, strings.Index(string(input), "to "), 9, 6, "", "Transfers", "0138 to 0138e1\n     ^"},
+		{8, len(input), 9, 15, "", "Transfers", "0138 to 0138e1\n              ^"},
+		{9, len(input) + 5, 9, 15, "", "Transfers", "0138 to 0138e1\n              ^"},
+	} {
+		d := NewDiagnostic("test.txt", input, tc.offset, nil, "oops")
+		if d.Line != tc.line {
+			t.Errorf("%d: line: expected %d: got %d\n", tc.id, tc.line, d.Line)
+		}
+		if d.Column != tc.column {
+			t.Errorf("%d: column: expected %d: got %d\n", tc.id, tc.column, d.Column)
+		}
+		if d.Unit != tc.unit {
+			t.Errorf("%d: unit: expected %q: got %q\n", tc.id, tc.unit, d.Unit)
+		}
+		if d.Section != tc.section {
+			t.Errorf("%d: section: expected %q: got %q\n", tc.id, tc.section, d.Section)
+		}
+		if d.Excerpt != tc.excerpt {
+			t.Errorf("%d: excerpt: expected %q: got %q\n", tc.id, tc.excerpt, d.Excerpt)
+		}
+	}
+}
+
+func TestNewDiagnosticMessage(t *testing.T) {
+	input := []byte("Tribe 0138, , Current Hex = AA 0101\r\nCurrent Turn\r\n")
+	d := NewDiagnostic("test.txt", input, len("Tribe 0138, , Current Hex = AA 0101\r\nCurrent Turn"), []string{`" "`, `"\n"`}, "unexpected input")
+	if expect := `unexpected input, expected " " or "\n"`; d.Message != expect {
+		t.Errorf("message: expected %q: got %q\n", expect, d.Message)
+	}
+	// the carriage return is not part of the excerpt
+	if expect := "Current Turn\n            ^"; d.Excerpt != expect {
+		t.Errorf("excerpt: expected %q: got %q\n", expect, d.Excerpt)
+	}
+	expect := "test.txt:2:13: unit 0138: Current Turn: " + d.Message + "\n" + d.Excerpt
+	if got := d.Error(); got != expect {
+		t.Errorf("error: expected %q: got %q\n", expect, got)
+	}
+}
+
+func TestDiagnose(t *testing.T) {
+	// the report stops parsing at the line that isn't a unit report
+	input := []byte("Tribe 0138, , Current Hex = AA 0101, (Previous Hex = AA 0101)\n" +
+		"Current Turn 900-01 (#1), Spring, FINE\tNext Turn 900-02 (#2), 28/11/2023\n" +
+		"\fThis is not a unit report\n")
+	raw, err := Parse("test.txt", input)
+	rpt, _ := raw.(*Report)
+	diags := Diagnose("test.txt", input, rpt, err)
+	if len(diags) == 0 {
+		t.Fatalf("expected diagnostics: got none\n")
+	}
+	d := diags[len(diags)-1]
+	if d.File != "test.txt" {
+		t.Errorf("file: expected %q: got %q\n", "test.txt", d.File)
+	}
+	if d.Line != 3 {
+		t.Errorf("line: expected %d: got %d\n", 3, d.Line)
+	}
+	if !strings.HasPrefix(d.Excerpt, "\fThis is not a unit report\n") {
+		t.Errorf("excerpt: expected the line that failed: got %q\n", d.Excerpt)
+	}
+	if !strings.HasPrefix(d.Message, "unexpected input") {
+		t.Errorf("message: expected %q: got %q\n", "unexpected input", d.Message)
+	}
+
+	// a clean report has no diagnostics
+	path := filepath.Join("..", "..", "..", "..", "testdata", "turn-reports", "0999.900-01.regular.txt")
+	input, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input = NormalizeReport(input, "AA")
+	raw, err = Parse(path, input)
+	rpt, _ = raw.(*Report)
+	if diags := Diagnose(path, input, rpt, err); len(diags) != 0 {
+		t.Errorf("expected no diagnostics: got %v\n", diags)
+	}
+}
+
+// TestBadUnitReport checks that a malformed unit report is skipped and
+// reported, and the valid units after it still parse.
+func TestBadUnitReport(t *testing.T) {
+	path := filepath.Join("..", "..", "..", "..", "testdata", "turn-reports", "0999.900-02.couriers-and-elements.txt")
+	input, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input = NormalizeReport(input, "AA")
+	raw, err := Parse(path, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := raw.(*Report)
+
+	// break the first unit report
+	bad := []byte(strings.Replace(string(input), "Goods Tribe: No GT", "Goods Tribe: ???", 1))
+	raw, err = Parse(path, bad)
+	rpt, ok := raw.(*Report)
+	if !ok {
+		t.Fatalf("expected report: got %T: %v\n", raw, err)
+	}
+	if len(rpt.T) != len(want.T) {
+		t.Errorf("expected %d units: got %d\n", len(want.T), len(rpt.T))
+	}
+	for id, u := range want.T {
+		got, ok := rpt.T[id]
+		switch {
+		case !ok:
+			t.Errorf("%s: expected unit: got none\n", id)
+		case id == "0999":
+			if len(got.Errors) != 1 {
+				t.Errorf("%s: expected 1 error: got %d\n", id, len(got.Errors))
+			}
+		case len(got.Errors) != 0:
+			t.Errorf("%s: expected no errors: got %v\n", id, got.Errors)
+		default:
+			wantJSON, _ := json.Marshal(u)
+			gotJSON, _ := json.Marshal(got)
+			if string(wantJSON) != string(gotJSON) {
+				t.Errorf("%s: expected %s: got %s\n", id, wantJSON, gotJSON)
+			}
+		}
+	}
+	if (rpt.Transfers == nil) != (want.Transfers == nil) {
+		t.Errorf("expected transfers %v: got %v\n", want.Transfers != nil, rpt.Transfers != nil)
+	}
+
+	// the diagnostic points at the line that broke the unit
+	diags := Diagnose(path, bad, rpt, err)
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic: got %d: %v\n", len(diags), diags)
+	}
+	d := diags[0]
+	line := 1 + strings.Count(string(bad[:strings.Index(string(bad), "Goods Tribe: ???")]), "\n")
+	if d.Line != line || d.Unit != "0999" || d.Section != "Goods Tribe" {
+		t.Errorf("expected line %d, unit 0999, Goods Tribe: got line %d, unit %q, %q\n", line, d.Line, d.Unit, d.Section)
+	}
+	if !strings.HasPrefix(d.Excerpt, "Goods Tribe: ???\n") {
+		t.Errorf("expected excerpt of the bad line: got %q\n", d.Excerpt)
+	}
+}
@@ -5,7 +5,9 @@ package parser
 
 import (
 	"bytes"
+	"github.com/mdhender/chief/internal/docconv"
 	"regexp"
+	"strings"
 )
 
 // FilterDefaultGrid searches the provided input []byte for all occurrences of ' ## '
@@ -64,3 +66,156 @@ func TransformMarkScoutLines(input []byte) []byte {
 
 	return bytes.Join(lines, []byte{'\n'})
 }
+
+// NormalizeReport prepares the text of a turn report for the parser.
+// It applies all the filters and transforms in the order the parser expects,
+// so that text extracted from a .docx file parses without manual editing.
+//
+// Parameters:
+//   - input ([]byte): The text of the turn report.
+//   - grid (string): The default grid, used to replace ' ## ' in hex ids.
+//     If grid is empty or "##", the hidden grids are kept.
+//
+// Returns:
+//   - ([]byte): The normalized text.
+func NormalizeReport(input []byte, grid string) []byte {
+	input = FilterLineEndings(input)
+	if len(grid) == 2 && grid != "##" {
+		input = FilterDefaultGrid(input, grid)
+	}
+	input = TransformJoinWrappedLines(input)
+	input = TransformInsertSentinels(input)
+	input = TransformMarkScoutLines(input)
+	return input
+}
+
+// NormalizeDocument prepares a turn report read from a .docx file for the
+// parser. It does the same job as NormalizeReport, but the sentinels come
+// from the structure of the document: every unit and clan section starts at
+// a paragraph, so a heading can't be confused with a line that the word
+// processor wrapped, and wrapped lines don't need to be joined.
+//
+// Parameters:
+//   - doc (*docconv.Document): The paragraphs of the turn report.
+//   - grid (string): The default grid, used to replace ' ## ' in hex ids.
+//     If grid is empty or "##", the hidden grids are kept.
+//
+// Returns:
+//   - ([]byte): The normalized text.
+func NormalizeDocument(doc *docconv.Document, grid string) []byte {
+	var sections [][]byte
+	inClanSection := false
+	for _, section := range doc.Sections(isSectionHeading) {
+		heading := strings.TrimSpace(section.Paragraphs[0].Text())
+		if !isSectionHeading(section.Paragraphs[0]) {
+			// drop everything before the first unit report
+			continue
+		}
+		// the page breaks inside a section aren't sentinels
+		text := bytes.ReplaceAll(section.Render(), []byte{'\f'}, nil)
+		if inClanSection = heading == "Transfers" || heading == "Settlements"; inClanSection {
+			_, rest, _ := bytes.Cut(text, []byte{'\n'})
+			text = append([]byte(heading+"\n"), rest...)
+		}
+		sections = append(sections, text)
+	}
+	if len(sections) != 0 && !inClanSection {
+		sections = append(sections, nil)
+	}
+	input := bytes.Join(sections, []byte{'\f'})
+	input = FilterLineEndings(input)
+	if len(grid) == 2 && grid != "##" {
+		input = FilterDefaultGrid(input, grid)
+	}
+	return TransformMarkScoutLines(input)
+}
+
+// isSectionHeading returns true if the paragraph starts a unit report
+// or one of the clan sections.
+func isSectionHeading(p *docconv.Paragraph) bool {
+	text := p.Text()
+	if reUnitHeading.MatchString(text) {
+		return true
+	}
+	heading := strings.TrimSpace(text)
+	return heading == "Transfers" || heading == "Settlements"
+}
+
+// FilterLineEndings replaces carriage-return line endings with new-lines and
+// non-breaking spaces with plain spaces.
+func FilterLineEndings(input []byte) []byte {
+	input = bytes.ReplaceAll(input, []byte{'\r', '\n'}, []byte{'\n'})
+	input = bytes.ReplaceAll(input, []byte{'\r'}, []byte{'\n'})
+	return bytes.ReplaceAll(input, []byte("\u00a0"), []byte{' '})
+}
+
+var (
+	// reUnitHeading matches the first line of a unit report, like "Tribe 0138, ".
+	reUnitHeading = regexp.MustCompile(`^(Tribe|Courier|Element) \d{4}([ce]\d)?,`)
+	// reWrappedLine matches lines that may be wrapped onto the following lines.
+	reWrappedLine = regexp.MustCompile(`^(Tribe Movement:|Scout \d:Scout)`)
+	// reLineStart matches lines that start something new, so they are never
+	// joined to a wrapped line.
+	reLineStart = regexp.MustCompile(`^(Tribe |Courier |Element |Scout \d:|\d{4}([ce]\d)? Status:|Humans|Transfers|Settlements|\f)`)
+)
+
+// TransformJoinWrappedLines joins "Tribe Movement" and "Scout" lines that were
+// wrapped onto multiple lines back into a single line.
+//
+// A line is treated as a continuation when it follows a movement or scout line,
+// is not blank, and does not start a new section or unit.
+// Continuations are joined with a single space, unless the previous line ends
+// with a backslash (the separator between moves).
+func TransformJoinWrappedLines(input []byte) []byte {
+	var lines [][]byte
+	joining := false
+	for _, line := range bytes.Split(input, []byte{'\n'}) {
+		if joining && len(bytes.TrimSpace(line)) != 0 && !reLineStart.Match(line) {
+			prev := bytes.TrimRight(lines[len(lines)-1], " \t")
+			if !bytes.HasSuffix(prev, []byte{'\\'}) {
+				prev = append(prev, ' ')
+			}
+			lines[len(lines)-1] = append(prev, bytes.TrimLeft(line, " \t")...)
+			continue
+		}
+		lines = append(lines, line)
+		joining = reWrappedLine.Match(line)
+	}
+	return bytes.Join(lines, []byte{'\n'})
+}
+
+// TransformInsertSentinels places the form-feed sentinels that the parser
+// uses to find the end of each unit report and the clan sections.
+//
+// Any existing form-feeds are removed, then a form-feed is inserted before
+// each unit heading (except the first), before the "Transfers" and
+// "Settlements" headings, and after the last unit report if there are
+// no clan sections. Text before the first unit heading (for example,
+// the page header from a .docx file) is dropped.
+func TransformInsertSentinels(input []byte) []byte {
+	input = bytes.ReplaceAll(input, []byte{'\f'}, nil)
+
+	var lines [][]byte
+	inUnit, inClanSection := false, false
+	for _, line := range bytes.Split(input, []byte{'\n'}) {
+		heading := string(bytes.TrimSpace(line))
+		switch {
+		case reUnitHeading.Match(line):
+			if inUnit {
+				line = append([]byte{'\f'}, line...)
+			}
+			inUnit = true
+		case !inUnit:
+			// drop everything before the first unit report
+			continue
+		case heading == "Transfers" || heading == "Settlements":
+			line = append([]byte{'\f'}, heading...)
+			inClanSection = true
+		}
+		lines = append(lines, line)
+	}
+	if inUnit && !inClanSection {
+		lines = append(lines, []byte{'\f'})
+	}
+	return bytes.Join(lines, []byte{'\n'})
+}
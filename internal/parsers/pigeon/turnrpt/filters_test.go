@@ -0,0 +1,188 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package parser
+
+import (
+	"encoding/json"
+	"github.com/mdhender/chief/internal/docconv"
+	"github.com/mdhender/chief/internal/golden"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestFilterLineEndings(t *testing.T) {
+	input := "a\r\nb\rc\u00a0d\n"
+	if got := string(FilterLineEndings([]byte(input))); got != "a\nb\nc d\n" {
+		t.Errorf("expected %q: got %q\n", "a\nb\nc d\n", got)
+	}
+}
+
+func TestTransformJoinWrappedLines(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect string
+	}{
+		{1, "Tribe Movement: Move SE-PR\\\nSE-GH, River S\\", "Tribe Movement: Move SE-PR\\SE-GH, River S\\"},
+		{2, "Scout 1:Scout N-PR\\ N-GH, O\n  N\\ Nothing\nScout 2:Scout S-PR", "Scout 1:Scout N-PR\\ N-GH, O N\\ Nothing\nScout 2:Scout S-PR"},
+		{3, "Tribe Movement: Move N-PR\\\n\nScout 1:Scout N-PR", "Tribe Movement: Move N-PR\\\n\nScout 1:Scout N-PR"},
+		{4, "Tribe Movement: Move N-PR\\\n0138 Status: PRAIRIE", "Tribe Movement: Move N-PR\\\n0138 Status: PRAIRIE"},
+		{5, "Final Activities:\nTribe Movement: Move N-PR\\", "Final Activities:\nTribe Movement: Move N-PR\\"},
+	} {
+		if got := string(TransformJoinWrappedLines([]byte(tc.input))); got != tc.expect {
+			t.Errorf("%d: expected %q: got %q\n", tc.id, tc.expect, got)
+		}
+	}
+}
+
+func TestTransformInsertSentinels(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  string
+		expect string
+	}{
+		{1, "Page 1\nTribe 0138, , x\nA\nElement 0138e1, , y\nB", "Tribe 0138, , x\nA\n\fElement 0138e1, , y\nB\n\f"},
+		{2, "Tribe 0138, , x\nTransfers \n0138 to 0138e1: 1 Horse\nSettlements", "Tribe 0138, , x\n\fTransfers\n0138 to 0138e1: 1 Horse\n\fSettlements"},
+		{3, "\fTribe 0138, , x\n\f\fTransfers", "Tribe 0138, , x\n\fTransfers"},
+		{4, "no units", ""},
+	} {
+		if got := string(TransformInsertSentinels([]byte(tc.input))); got != tc.expect {
+			t.Errorf("%d: expected %q: got %q\n", tc.id, tc.expect, got)
+		}
+	}
+}
+
+// TestNormalizeReport checks that a report as it comes out of the .docx,
+// with a page header, no sentinels, Windows line endings, non-breaking
+// spaces and wrapped movement lines, parses the same as the corpus.
+func TestNormalizeReport(t *testing.T) {
+	testdata := filepath.Join("..", "..", "..", "..", "testdata")
+	// a line with moves is wrapped at the first comma, the way a word
+	// processor would wrap it at a space
+	reWrap := regexp.MustCompile(`(?m)^((?:Tribe Movement: Move|Scout \d:Scout) [^,\n]*,) `)
+	wrapped := 0
+	for _, path := range golden.Corpus(t, filepath.Join(testdata, "turn-reports")) {
+		name := golden.Name(path)
+		if strings.HasSuffix(name, ".setup") {
+			// the set-up report needs hand edits
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			input, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			raw := strings.ReplaceAll(string(input), "\f", "")
+			if reWrap.MatchString(raw) {
+				raw = reWrap.ReplaceAllString(raw, "$1\n")
+				wrapped++
+			}
+			raw = strings.Replace(raw, "Current Hex", "Current\u00a0Hex", 1)
+			raw = "TribeNet Turn Report\nPage 1\n\n" + raw
+			raw = strings.ReplaceAll(raw, "\n", "\r\n")
+
+			want, err := Parse(name, NormalizeReport(input, "AA"))
+			if err != nil {
+				t.Fatalf("corpus: %v\n", err)
+			}
+			got, err := Parse(name, NormalizeReport([]byte(raw), "AA"))
+			if err != nil {
+				t.Fatalf("raw: %v\n", err)
+			}
+			wantJSON, _ := json.MarshalIndent(want, "", "  ")
+			gotJSON, _ := json.MarshalIndent(got, "", "  ")
+			if string(wantJSON) != string(gotJSON) {
+				t.Errorf("expected the same report as the corpus:\nwant %s\ngot %s\n", wantJSON, gotJSON)
+			}
+		})
+	}
+	if wrapped == 0 {
+		t.Errorf("expected a report with wrapped lines\n")
+	}
+}
+
+func TestNormalizeDocumentSections(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		input  []string
+		expect string
+	}{
+		{1, []string{"Page 1", "Tribe 0138, , x", "A", "Element 0138e1, , y", "B"}, "Tribe 0138, , x\nA\n\fElement 0138e1, , y\nB\n\f"},
+		{2, []string{"Tribe 0138, , x", "Transfers ", "0138 to 0138e1: 1 Horse", "Settlements"}, "Tribe 0138, , x\n\fTransfers\n0138 to 0138e1: 1 Horse\n\fSettlements\n"},
+		{3, []string{"Tribe 0138, , x", "Tribe Movement: Move N-PR\\", "N-GH"}, "Tribe 0138, , x\nTribe Movement: Move N-PR\\\nN-GH\n\f"},
+		{4, []string{"no units"}, ""},
+	} {
+		doc := &docconv.Document{}
+		for _, text := range tc.input {
+			doc.Paragraphs = append(doc.Paragraphs, &docconv.Paragraph{Runs: []*docconv.Run{{Text: text}}})
+		}
+		if got := string(NormalizeDocument(doc, "")); got != tc.expect {
+			t.Errorf("%d: expected %q: got %q\n", tc.id, tc.expect, got)
+		}
+	}
+}
+
+// TestNormalizeDocument checks that a report read from the paragraphs
+// of a .docx file, with a page header, page breaks and tables, parses
+// the same as the corpus.
+func TestNormalizeDocument(t *testing.T) {
+	testdata := filepath.Join("..", "..", "..", "..", "testdata")
+	for _, path := range golden.Corpus(t, filepath.Join(testdata, "turn-reports")) {
+		name := golden.Name(path)
+		if strings.HasSuffix(name, ".setup") {
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			input, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			doc := &docconv.Document{}
+			addParagraph := func(text string, pageBreak bool, cell *docconv.Cell) {
+				doc.Paragraphs = append(doc.Paragraphs, &docconv.Paragraph{
+					PageBreak: pageBreak,
+					Cell:      cell,
+					Runs:      []*docconv.Run{{Text: text}},
+				})
+			}
+			addParagraph("TribeNet Turn Report", false, nil)
+			addParagraph("Page 1", false, nil)
+			tables := 0
+			for _, line := range strings.Split(strings.TrimRight(strings.ReplaceAll(string(input), "\f", ""), "\n"), "\n") {
+				switch {
+				case reUnitHeading.MatchString(line):
+					addParagraph(line, true, nil)
+				case strings.Contains(line, "\t"):
+					// a row of cells, each in its own table
+					for col, text := range strings.Split(line, "\t") {
+						addParagraph(text, false, &docconv.Cell{Table: tables, Col: col})
+					}
+					tables++
+				case strings.HasPrefix(line, "Final Activities:"):
+					// a page break that isn't a sentinel
+					addParagraph(line, true, nil)
+				default:
+					addParagraph(line, false, nil)
+				}
+			}
+
+			want, err := Parse(name, NormalizeReport(input, "AA"))
+			if err != nil {
+				t.Fatalf("corpus: %v\n", err)
+			}
+			got, err := Parse(name, NormalizeDocument(doc, "AA"))
+			if err != nil {
+				t.Fatalf("document: %v\n", err)
+			}
+			wantJSON, _ := json.MarshalIndent(want, "", "  ")
+			gotJSON, _ := json.MarshalIndent(got, "", "  ")
+			if string(wantJSON) != string(gotJSON) {
+				t.Errorf("expected the same report as the corpus:\nwant %s\ngot %s\n", wantJSON, gotJSON)
+			}
+		})
+	}
+}
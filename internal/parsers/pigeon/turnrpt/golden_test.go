@@ -0,0 +1,34 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package parser
+
+import (
+	"github.com/mdhender/chief/internal/golden"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGolden(t *testing.T) {
+	testdata := filepath.Join("..", "..", "..", "..", "testdata")
+	for _, path := range golden.Corpus(t, filepath.Join(testdata, "turn-reports")) {
+		name := golden.Name(path)
+		t.Run(name, func(t *testing.T) {
+			input, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			input = NormalizeReport(input, "AA")
+			raw, err := Parse(filepath.Base(path), input)
+			rpt, _ := raw.(*Report)
+			golden.Compare(t, filepath.Join(testdata, "golden", "pigeon", name+".json"), struct {
+				Report      *Report       `json:"report"`
+				Diagnostics []*Diagnostic `json:"diagnostics,omitempty"`
+			}{
+				Report:      rpt,
+				Diagnostics: Diagnose(filepath.Base(path), input, rpt, err),
+			})
+		})
+	}
+}
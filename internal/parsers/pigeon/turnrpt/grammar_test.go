@@ -0,0 +1,57 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package parser
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestPossessions(t *testing.T) {
+	path := filepath.Join("..", "..", "..", "..", "testdata", "turn-reports", "0999.900-01.regular.txt")
+	input, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		id      int
+		animals string // replaces the first line of animals
+		expect  string // the possessions as json
+		err     bool
+	}{
+		{1, "Cattle\t100\tGoat\t300\tHorse\t200",
+			`{"animals":{"items":{"Cattle":100,"Goat":300,"Horse":200}},"minerals":{"items":{"Coal":10}},"war-equipment":{"items":{"Club":500,"Jerkin":200}},"finished-goods":{"items":{"Provs":4500,"Trap":10}},"raw-materials":{"items":{"Log":20}},"ships":{}}`,
+			false},
+		// an item without a quantity leaves the rest of the section in the bleet
+		{2, "Cattle\tlots\tGoat\t300\tHorse\t200",
+			`{"animals":{"bleet":"Cattle\tlots\tGoat\t300\tHorse\t200"},"minerals":{"items":{"Coal":10}},"war-equipment":{"items":{"Club":500,"Jerkin":200}},"finished-goods":{"items":{"Provs":4500,"Trap":10}},"raw-materials":{"items":{"Log":20}},"ships":{}}`,
+			false},
+		{3, "Cattle\t99999999999999999999\tGoat\t300\tHorse\t200", "", true},
+	} {
+		in := strings.Replace(string(input), "Cattle\t100\tGoat\t300\tHorse\t200", tc.animals, 1)
+		raw, err := Parse(path, NormalizeReport([]byte(in), "AA"))
+		if tc.err {
+			if err == nil || !strings.Contains(err.Error(), "rule itemQuantity") {
+				t.Errorf("%d: expected itemQuantity error: got %v\n", tc.id, err)
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("%d: expected no error: got %v\n", tc.id, err)
+			continue
+		}
+		unit, ok := raw.(*Report).T["0999"]
+		if !ok || unit.Possessions == nil {
+			t.Errorf("%d: expected possessions: got none\n", tc.id)
+			continue
+		}
+		got, _ := json.Marshal(unit.Possessions)
+		if string(got) != tc.expect {
+			t.Errorf("%d: expected %s: got %s\n", tc.id, tc.expect, got)
+		}
+	}
+}
@@ -17,7 +17,6 @@ import (
 	"unicode"
 	"unicode/utf8"
 	//"log"
-	//"strings"
 	"strconv"
 )
 
@@ -30,6 +29,14 @@ func atoi(o any) (int, error) {
 	return 0, fmt.Errorf("invalid integer")
 }
 
+// atoiCommas converts a number like "1,234" to an integer.
+func atoiCommas(o any) (int, error) {
+	if s, ok := o.(string); ok {
+		return strconv.Atoi(strings.ReplaceAll(s, ",", ""))
+	}
+	return 0, fmt.Errorf("invalid integer")
+}
+
 func toAnySlice(v any) []any {
 	if v == nil {
 		return nil
@@ -37,38 +44,107 @@ func toAnySlice(v any) []any {
 	return v.([]any)
 }
 
+// toItems converts a slice of items to a map of item name to quantity.
+// Items that are listed more than once are summed.
+func toItems(v any) map[string]int {
+	items := make(map[string]int)
+	for _, item := range toAnySlice(v) {
+		if item, ok := item.(*Item); ok {
+			items[item.Name] += item.Quantity
+		}
+	}
+	return items
+}
+
 var g = &grammar{
 	rules: []*rule{
 		{
 			name: "ReportFile",
-			pos:  position{line: 32, col: 1, offset: 394},
+			pos:  position{line: 52, col: 1, offset: 996},
 			expr: &actionExpr{
-				pos: position{line: 32, col: 15, offset: 408},
+				pos: position{line: 52, col: 15, offset: 1010},
 				run: (*parser).callonReportFile1,
 				expr: &seqExpr{
-					pos: position{line: 32, col: 15, offset: 408},
+					pos: position{line: 52, col: 15, offset: 1010},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 32, col: 15, offset: 408},
+							pos:   position{line: 52, col: 15, offset: 1010},
 							label: "rptsi",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 32, col: 21, offset: 414},
+								pos: position{line: 52, col: 21, offset: 1016},
+								expr: &choiceExpr{
+									pos: position{line: 52, col: 22, offset: 1017},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 52, col: 22, offset: 1017},
+											name: "UnitReport",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 52, col: 35, offset: 1030},
+											name: "BadUnitReport",
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 52, col: 51, offset: 1046},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 52, col: 53, offset: 1048},
+							label: "transfers",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 52, col: 63, offset: 1058},
+								expr: &ruleRefExpr{
+									pos:  position{line: 52, col: 63, offset: 1058},
+									name: "Transfers",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 52, col: 74, offset: 1069},
+							expr: &ruleRefExpr{
+								pos:  position{line: 52, col: 74, offset: 1069},
+								name: "FF",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 52, col: 78, offset: 1073},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 52, col: 80, offset: 1075},
+							label: "settlements",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 52, col: 92, offset: 1087},
 								expr: &ruleRefExpr{
-									pos:  position{line: 32, col: 21, offset: 414},
-									name: "UnitReport",
+									pos:  position{line: 52, col: 92, offset: 1087},
+									name: "Settlements",
 								},
 							},
 						},
+						&zeroOrOneExpr{
+							pos: position{line: 52, col: 105, offset: 1100},
+							expr: &ruleRefExpr{
+								pos:  position{line: 52, col: 105, offset: 1100},
+								name: "FF",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 52, col: 109, offset: 1104},
+							name: "_",
+						},
 						&labeledExpr{
-							pos:   position{line: 32, col: 33, offset: 426},
+							pos:   position{line: 52, col: 111, offset: 1106},
 							label: "rest",
 							expr: &ruleRefExpr{
-								pos:  position{line: 32, col: 38, offset: 431},
+								pos:  position{line: 52, col: 116, offset: 1111},
 								name: "REST",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 32, col: 43, offset: 436},
+							pos:  position{line: 52, col: 121, offset: 1116},
 							name: "EOF",
 						},
 					},
@@ -77,30 +153,30 @@ var g = &grammar{
 		},
 		{
 			name: "UnitReport",
-			pos:  position{line: 50, col: 1, offset: 776},
+			pos:  position{line: 77, col: 1, offset: 1623},
 			expr: &actionExpr{
-				pos: position{line: 50, col: 15, offset: 790},
+				pos: position{line: 77, col: 15, offset: 1637},
 				run: (*parser).callonUnitReport1,
 				expr: &seqExpr{
-					pos: position{line: 50, col: 15, offset: 790},
+					pos: position{line: 77, col: 15, offset: 1637},
 					exprs: []any{
 						&choiceExpr{
-							pos: position{line: 50, col: 16, offset: 791},
+							pos: position{line: 77, col: 16, offset: 1638},
 							alternatives: []any{
 								&litMatcher{
-									pos:        position{line: 50, col: 16, offset: 791},
+									pos:        position{line: 77, col: 16, offset: 1638},
 									val:        "Tribe",
 									ignoreCase: false,
 									want:       "\"Tribe\"",
 								},
 								&litMatcher{
-									pos:        position{line: 50, col: 26, offset: 801},
+									pos:        position{line: 77, col: 26, offset: 1648},
 									val:        "Courier",
 									ignoreCase: false,
 									want:       "\"Courier\"",
 								},
 								&litMatcher{
-									pos:        position{line: 50, col: 38, offset: 813},
+									pos:        position{line: 77, col: 38, offset: 1660},
 									val:        "Element",
 									ignoreCase: false,
 									want:       "\"Element\"",
@@ -108,446 +184,599 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 50, col: 49, offset: 824},
+							pos:  position{line: 77, col: 49, offset: 1671},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 50, col: 51, offset: 826},
+							pos:   position{line: 77, col: 51, offset: 1673},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 50, col: 54, offset: 829},
+								pos:  position{line: 77, col: 54, offset: 1676},
 								name: "UNITID",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 51, col: 2, offset: 837},
+							pos:  position{line: 78, col: 2, offset: 1684},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 51, col: 4, offset: 839},
+							pos:   position{line: 78, col: 4, offset: 1686},
 							label: "commonHeadingi",
 							expr: &ruleRefExpr{
-								pos:  position{line: 51, col: 19, offset: 854},
+								pos:  position{line: 78, col: 19, offset: 1701},
 								name: "CommonHeading",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 52, col: 2, offset: 869},
+							pos:  position{line: 79, col: 2, offset: 1716},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 52, col: 4, offset: 871},
+							pos: position{line: 79, col: 4, offset: 1718},
 							expr: &ruleRefExpr{
-								pos:  position{line: 52, col: 4, offset: 871},
+								pos:  position{line: 79, col: 4, offset: 1718},
 								name: "ClanHeading",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 53, col: 2, offset: 885},
+							pos:  position{line: 80, col: 2, offset: 1732},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 53, col: 4, offset: 887},
+							pos:   position{line: 80, col: 4, offset: 1734},
 							label: "goodsTribe",
 							expr: &ruleRefExpr{
-								pos:  position{line: 53, col: 15, offset: 898},
+								pos:  position{line: 80, col: 15, offset: 1745},
 								name: "GoodsTribe",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 54, col: 2, offset: 910},
+							pos:  position{line: 81, col: 2, offset: 1757},
 							name: "_",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 54, col: 4, offset: 912},
+							pos: position{line: 81, col: 4, offset: 1759},
 							expr: &ruleRefExpr{
-								pos:  position{line: 54, col: 4, offset: 912},
+								pos:  position{line: 81, col: 4, offset: 1759},
 								name: "DesiredCommodities",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 55, col: 2, offset: 933},
+							pos:  position{line: 82, col: 2, offset: 1780},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 55, col: 4, offset: 935},
+							pos:   position{line: 82, col: 4, offset: 1782},
 							label: "gmNotes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 55, col: 12, offset: 943},
+								pos: position{line: 82, col: 12, offset: 1790},
 								expr: &ruleRefExpr{
-									pos:  position{line: 55, col: 12, offset: 943},
+									pos:  position{line: 82, col: 12, offset: 1790},
 									name: "GMNotes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 56, col: 2, offset: 953},
+							pos:  position{line: 83, col: 2, offset: 1800},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 56, col: 4, offset: 955},
+							pos:   position{line: 83, col: 4, offset: 1802},
 							label: "tact",
 							expr: &ruleRefExpr{
-								pos:  position{line: 56, col: 9, offset: 960},
+								pos:  position{line: 83, col: 9, offset: 1807},
 								name: "TribeActivities",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 57, col: 2, offset: 977},
+							pos:  position{line: 84, col: 2, offset: 1824},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 57, col: 4, offset: 979},
+							pos:   position{line: 84, col: 4, offset: 1826},
 							label: "fact",
 							expr: &ruleRefExpr{
-								pos:  position{line: 57, col: 9, offset: 984},
+								pos:  position{line: 84, col: 9, offset: 1831},
 								name: "FinalActivities",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 58, col: 2, offset: 1001},
+							pos:  position{line: 85, col: 2, offset: 1848},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 58, col: 4, offset: 1003},
+							pos:   position{line: 85, col: 4, offset: 1850},
 							label: "tmove",
 							expr: &ruleRefExpr{
-								pos:  position{line: 58, col: 10, offset: 1009},
+								pos:  position{line: 85, col: 10, offset: 1856},
 								name: "TribeMovement",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 59, col: 2, offset: 1024},
+							pos:  position{line: 86, col: 2, offset: 1871},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 59, col: 4, offset: 1026},
+							pos:   position{line: 86, col: 4, offset: 1873},
 							label: "scouts",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 59, col: 11, offset: 1033},
+								pos: position{line: 86, col: 11, offset: 1880},
 								expr: &ruleRefExpr{
-									pos:  position{line: 59, col: 11, offset: 1033},
+									pos:  position{line: 86, col: 11, offset: 1880},
 									name: "ScoutActions",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 60, col: 2, offset: 1048},
+							pos:  position{line: 87, col: 2, offset: 1895},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 60, col: 4, offset: 1050},
+							pos:   position{line: 87, col: 4, offset: 1897},
 							label: "status",
 							expr: &ruleRefExpr{
-								pos:  position{line: 60, col: 11, offset: 1057},
+								pos:  position{line: 87, col: 11, offset: 1904},
 								name: "UnitStatus",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 61, col: 2, offset: 1069},
+							pos:  position{line: 88, col: 2, offset: 1916},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 61, col: 4, offset: 1071},
+							pos:   position{line: 88, col: 4, offset: 1918},
 							label: "people",
 							expr: &ruleRefExpr{
-								pos:  position{line: 61, col: 11, offset: 1078},
+								pos:  position{line: 88, col: 11, offset: 1925},
 								name: "Humans",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 62, col: 2, offset: 1086},
+							pos:  position{line: 89, col: 2, offset: 1933},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 62, col: 4, offset: 1088},
+							pos:   position{line: 89, col: 4, offset: 1935},
 							label: "possessions",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 62, col: 16, offset: 1100},
+								pos: position{line: 89, col: 16, offset: 1947},
 								expr: &ruleRefExpr{
-									pos:  position{line: 62, col: 16, offset: 1100},
+									pos:  position{line: 89, col: 16, offset: 1947},
 									name: "Possessions",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 63, col: 2, offset: 1114},
+							pos:  position{line: 90, col: 2, offset: 1961},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 63, col: 4, offset: 1116},
+							pos:   position{line: 90, col: 4, offset: 1963},
 							label: "skills",
 							expr: &ruleRefExpr{
-								pos:  position{line: 63, col: 11, offset: 1123},
+								pos:  position{line: 90, col: 11, offset: 1970},
 								name: "Skills",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 64, col: 2, offset: 1131},
+							pos:  position{line: 91, col: 2, offset: 1978},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 64, col: 4, offset: 1133},
+							pos:   position{line: 91, col: 4, offset: 1980},
 							label: "morale",
 							expr: &ruleRefExpr{
-								pos:  position{line: 64, col: 11, offset: 1140},
+								pos:  position{line: 91, col: 11, offset: 1987},
 								name: "Morale",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 65, col: 2, offset: 1148},
+							pos:  position{line: 92, col: 2, offset: 1995},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 65, col: 4, offset: 1150},
+							pos:   position{line: 92, col: 4, offset: 1997},
 							label: "weight",
 							expr: &ruleRefExpr{
-								pos:  position{line: 65, col: 11, offset: 1157},
+								pos:  position{line: 92, col: 11, offset: 2004},
 								name: "Weight",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 66, col: 2, offset: 1165},
+							pos:  position{line: 93, col: 2, offset: 2012},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 66, col: 4, offset: 1167},
+							pos:   position{line: 93, col: 4, offset: 2014},
 							label: "truces",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 66, col: 11, offset: 1174},
+								pos: position{line: 93, col: 11, offset: 2021},
 								expr: &ruleRefExpr{
-									pos:  position{line: 66, col: 11, offset: 1174},
+									pos:  position{line: 93, col: 11, offset: 2021},
 									name: "Truces",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 67, col: 2, offset: 1183},
+							pos:  position{line: 94, col: 2, offset: 2030},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 67, col: 4, offset: 1185},
+							pos:   position{line: 94, col: 4, offset: 2032},
 							label: "b",
 							expr: &ruleRefExpr{
-								pos:  position{line: 67, col: 6, offset: 1187},
+								pos:  position{line: 94, col: 6, offset: 2034},
 								name: "BLEET",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 67, col: 12, offset: 1193},
+							pos:  position{line: 94, col: 12, offset: 2040},
 							name: "FF",
 						},
 					},
 				},
 			},
 		},
+		{
+			name: "BadUnitReport",
+			pos:  position{line: 134, col: 1, offset: 3202},
+			expr: &actionExpr{
+				pos: position{line: 134, col: 18, offset: 3219},
+				run: (*parser).callonBadUnitReport1,
+				expr: &seqExpr{
+					pos: position{line: 134, col: 18, offset: 3219},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 134, col: 18, offset: 3219},
+							name: "unitKind",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 134, col: 27, offset: 3228},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 134, col: 29, offset: 3230},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 134, col: 32, offset: 3233},
+								name: "UNITID",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 134, col: 39, offset: 3240},
+							name: "untilNextUnit",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 134, col: 53, offset: 3254},
+							expr: &choiceExpr{
+								pos: position{line: 134, col: 54, offset: 3255},
+								alternatives: []any{
+									&ruleRefExpr{
+										pos:  position{line: 134, col: 54, offset: 3255},
+										name: "FF",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 134, col: 59, offset: 3260},
+										name: "NL",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "unitKind",
+			pos:  position{line: 142, col: 1, offset: 3425},
+			expr: &choiceExpr{
+				pos: position{line: 142, col: 13, offset: 3437},
+				alternatives: []any{
+					&litMatcher{
+						pos:        position{line: 142, col: 13, offset: 3437},
+						val:        "Tribe",
+						ignoreCase: false,
+						want:       "\"Tribe\"",
+					},
+					&litMatcher{
+						pos:        position{line: 142, col: 23, offset: 3447},
+						val:        "Courier",
+						ignoreCase: false,
+						want:       "\"Courier\"",
+					},
+					&litMatcher{
+						pos:        position{line: 142, col: 35, offset: 3459},
+						val:        "Element",
+						ignoreCase: false,
+						want:       "\"Element\"",
+					},
+				},
+			},
+		},
+		{
+			name: "untilNextUnit",
+			pos:  position{line: 144, col: 1, offset: 3470},
+			expr: &actionExpr{
+				pos: position{line: 144, col: 18, offset: 3487},
+				run: (*parser).callonuntilNextUnit1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 144, col: 18, offset: 3487},
+					expr: &seqExpr{
+						pos: position{line: 144, col: 19, offset: 3488},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 144, col: 19, offset: 3488},
+								expr: &choiceExpr{
+									pos: position{line: 144, col: 21, offset: 3490},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 144, col: 21, offset: 3490},
+											name: "FF",
+										},
+										&seqExpr{
+											pos: position{line: 144, col: 26, offset: 3495},
+											exprs: []any{
+												&ruleRefExpr{
+													pos:  position{line: 144, col: 26, offset: 3495},
+													name: "NL",
+												},
+												&choiceExpr{
+													pos: position{line: 144, col: 30, offset: 3499},
+													alternatives: []any{
+														&seqExpr{
+															pos: position{line: 144, col: 30, offset: 3499},
+															exprs: []any{
+																&ruleRefExpr{
+																	pos:  position{line: 144, col: 30, offset: 3499},
+																	name: "unitKind",
+																},
+																&oneOrMoreExpr{
+																	pos: position{line: 144, col: 39, offset: 3508},
+																	expr: &ruleRefExpr{
+																		pos:  position{line: 144, col: 39, offset: 3508},
+																		name: "SPACE",
+																	},
+																},
+																&ruleRefExpr{
+																	pos:  position{line: 144, col: 46, offset: 3515},
+																	name: "UNITID",
+																},
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 144, col: 55, offset: 3524},
+															val:        "Transfers",
+															ignoreCase: false,
+															want:       "\"Transfers\"",
+														},
+														&litMatcher{
+															pos:        position{line: 144, col: 69, offset: 3538},
+															val:        "Settlements",
+															ignoreCase: false,
+															want:       "\"Settlements\"",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+							&anyMatcher{
+								line: 144, col: 85, offset: 3554,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "CommonHeading",
-			pos:  position{line: 104, col: 1, offset: 2141},
+			pos:  position{line: 148, col: 1, offset: 3594},
 			expr: &actionExpr{
-				pos: position{line: 105, col: 2, offset: 2159},
+				pos: position{line: 149, col: 2, offset: 3612},
 				run: (*parser).callonCommonHeading1,
 				expr: &seqExpr{
-					pos: position{line: 105, col: 2, offset: 2159},
+					pos: position{line: 149, col: 2, offset: 3612},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 2, offset: 2159},
+							pos:  position{line: 149, col: 2, offset: 3612},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 4, offset: 2161},
+							pos:        position{line: 149, col: 4, offset: 3614},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 8, offset: 2165},
+							pos:  position{line: 149, col: 8, offset: 3618},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 10, offset: 2167},
+							pos:        position{line: 149, col: 10, offset: 3620},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 14, offset: 2171},
+							pos:  position{line: 149, col: 14, offset: 3624},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 16, offset: 2173},
+							pos:        position{line: 149, col: 16, offset: 3626},
 							val:        "Current Hex",
 							ignoreCase: false,
 							want:       "\"Current Hex\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 30, offset: 2187},
+							pos:  position{line: 149, col: 30, offset: 3640},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 32, offset: 2189},
+							pos:        position{line: 149, col: 32, offset: 3642},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 36, offset: 2193},
+							pos:  position{line: 149, col: 36, offset: 3646},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 105, col: 38, offset: 2195},
+							pos:   position{line: 149, col: 38, offset: 3648},
 							label: "currentHex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 105, col: 49, offset: 2206},
+								pos:  position{line: 149, col: 49, offset: 3659},
 								name: "HEXID",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 55, offset: 2212},
+							pos:  position{line: 149, col: 55, offset: 3665},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 57, offset: 2214},
+							pos:        position{line: 149, col: 57, offset: 3667},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 61, offset: 2218},
+							pos:  position{line: 149, col: 61, offset: 3671},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 63, offset: 2220},
+							pos:        position{line: 149, col: 63, offset: 3673},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 67, offset: 2224},
+							pos:  position{line: 149, col: 67, offset: 3677},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 69, offset: 2226},
+							pos:        position{line: 149, col: 69, offset: 3679},
 							val:        "Previous Hex",
 							ignoreCase: false,
 							want:       "\"Previous Hex\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 84, offset: 2241},
+							pos:  position{line: 149, col: 84, offset: 3694},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 86, offset: 2243},
+							pos:        position{line: 149, col: 86, offset: 3696},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 90, offset: 2247},
+							pos:  position{line: 149, col: 90, offset: 3700},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 105, col: 92, offset: 2249},
+							pos:   position{line: 149, col: 92, offset: 3702},
 							label: "startingHex",
 							expr: &ruleRefExpr{
-								pos:  position{line: 105, col: 104, offset: 2261},
+								pos:  position{line: 149, col: 104, offset: 3714},
 								name: "HEXID",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 105, col: 110, offset: 2267},
+							pos:  position{line: 149, col: 110, offset: 3720},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 105, col: 112, offset: 2269},
+							pos:        position{line: 149, col: 112, offset: 3722},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 2, offset: 2274},
+							pos:  position{line: 150, col: 2, offset: 3727},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 106, col: 4, offset: 2276},
+							pos:        position{line: 150, col: 4, offset: 3729},
 							val:        "Current Turn",
 							ignoreCase: false,
 							want:       "\"Current Turn\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 19, offset: 2291},
+							pos:  position{line: 150, col: 19, offset: 3744},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 106, col: 21, offset: 2293},
+							pos:   position{line: 150, col: 21, offset: 3746},
 							label: "turn",
 							expr: &ruleRefExpr{
-								pos:  position{line: 106, col: 26, offset: 2298},
+								pos:  position{line: 150, col: 26, offset: 3751},
 								name: "TURNID",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 33, offset: 2305},
+							pos:  position{line: 150, col: 33, offset: 3758},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 106, col: 35, offset: 2307},
+							pos:        position{line: 150, col: 35, offset: 3760},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 39, offset: 2311},
+							pos:  position{line: 150, col: 39, offset: 3764},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 41, offset: 2313},
+							pos:  position{line: 150, col: 41, offset: 3766},
 							name: "MONTHID",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 49, offset: 2321},
+							pos:  position{line: 150, col: 49, offset: 3774},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 106, col: 51, offset: 2323},
+							pos:        position{line: 150, col: 51, offset: 3776},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 55, offset: 2327},
+							pos:  position{line: 150, col: 55, offset: 3780},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 106, col: 57, offset: 2329},
+							pos:        position{line: 150, col: 57, offset: 3782},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 61, offset: 2333},
+							pos:  position{line: 150, col: 61, offset: 3786},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 63, offset: 2335},
+							pos:  position{line: 150, col: 63, offset: 3788},
 							name: "SEASON",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 70, offset: 2342},
+							pos:  position{line: 150, col: 70, offset: 3795},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 106, col: 72, offset: 2344},
+							pos:        position{line: 150, col: 72, offset: 3797},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 76, offset: 2348},
+							pos:  position{line: 150, col: 76, offset: 3801},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 106, col: 78, offset: 2350},
+							pos:  position{line: 150, col: 78, offset: 3803},
 							name: "WEATHER",
 						},
 					},
@@ -556,169 +785,169 @@ var g = &grammar{
 		},
 		{
 			name: "ClanHeading",
-			pos:  position{line: 115, col: 1, offset: 2513},
+			pos:  position{line: 159, col: 1, offset: 3966},
 			expr: &actionExpr{
-				pos: position{line: 116, col: 5, offset: 2532},
+				pos: position{line: 160, col: 5, offset: 3985},
 				run: (*parser).callonClanHeading1,
 				expr: &seqExpr{
-					pos: position{line: 116, col: 5, offset: 2532},
+					pos: position{line: 160, col: 5, offset: 3985},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 5, offset: 2532},
+							pos:  position{line: 160, col: 5, offset: 3985},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 116, col: 7, offset: 2534},
+							pos:        position{line: 160, col: 7, offset: 3987},
 							val:        "Next Turn",
 							ignoreCase: false,
 							want:       "\"Next Turn\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 19, offset: 2546},
+							pos:  position{line: 160, col: 19, offset: 3999},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 21, offset: 2548},
+							pos:  position{line: 160, col: 21, offset: 4001},
 							name: "TURNID",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 28, offset: 2555},
+							pos:  position{line: 160, col: 28, offset: 4008},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 116, col: 30, offset: 2557},
+							pos:        position{line: 160, col: 30, offset: 4010},
 							val:        "(",
 							ignoreCase: false,
 							want:       "\"(\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 34, offset: 2561},
+							pos:  position{line: 160, col: 34, offset: 4014},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 36, offset: 2563},
+							pos:  position{line: 160, col: 36, offset: 4016},
 							name: "MONTHID",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 44, offset: 2571},
+							pos:  position{line: 160, col: 44, offset: 4024},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 116, col: 46, offset: 2573},
+							pos:        position{line: 160, col: 46, offset: 4026},
 							val:        ")",
 							ignoreCase: false,
 							want:       "\")\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 50, offset: 2577},
+							pos:  position{line: 160, col: 50, offset: 4030},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 116, col: 52, offset: 2579},
+							pos:        position{line: 160, col: 52, offset: 4032},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 56, offset: 2583},
+							pos:  position{line: 160, col: 56, offset: 4036},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 116, col: 58, offset: 2585},
+							pos:  position{line: 160, col: 58, offset: 4038},
 							name: "DDMMYYYY",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 5, offset: 2598},
+							pos:  position{line: 161, col: 5, offset: 4051},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 117, col: 7, offset: 2600},
+							pos:        position{line: 161, col: 7, offset: 4053},
 							val:        "Received:",
 							ignoreCase: false,
 							want:       "\"Received:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 19, offset: 2612},
+							pos:  position{line: 161, col: 19, offset: 4065},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 117, col: 21, offset: 2614},
+							pos:        position{line: 161, col: 21, offset: 4067},
 							val:        "$",
 							ignoreCase: false,
 							want:       "\"$\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 25, offset: 2618},
+							pos:  position{line: 161, col: 25, offset: 4071},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 27, offset: 2620},
+							pos:  position{line: 161, col: 27, offset: 4073},
 							name: "NUMBER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 34, offset: 2627},
+							pos:  position{line: 161, col: 34, offset: 4080},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 117, col: 36, offset: 2629},
+							pos:        position{line: 161, col: 36, offset: 4082},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 40, offset: 2633},
+							pos:  position{line: 161, col: 40, offset: 4086},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 117, col: 42, offset: 2635},
+							pos:        position{line: 161, col: 42, offset: 4088},
 							val:        "Cost:",
 							ignoreCase: false,
 							want:       "\"Cost:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 50, offset: 2643},
+							pos:  position{line: 161, col: 50, offset: 4096},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 117, col: 52, offset: 2645},
+							pos:        position{line: 161, col: 52, offset: 4098},
 							val:        "$",
 							ignoreCase: false,
 							want:       "\"$\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 56, offset: 2649},
+							pos:  position{line: 161, col: 56, offset: 4102},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 58, offset: 2651},
+							pos:  position{line: 161, col: 58, offset: 4104},
 							name: "NUMBER",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 65, offset: 2658},
+							pos:  position{line: 161, col: 65, offset: 4111},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 117, col: 67, offset: 2660},
+							pos:        position{line: 161, col: 67, offset: 4113},
 							val:        "Credit:",
 							ignoreCase: false,
 							want:       "\"Credit:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 77, offset: 2670},
+							pos:  position{line: 161, col: 77, offset: 4123},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 117, col: 79, offset: 2672},
+							pos:        position{line: 161, col: 79, offset: 4125},
 							val:        "$",
 							ignoreCase: false,
 							want:       "\"$\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 83, offset: 2676},
+							pos:  position{line: 161, col: 83, offset: 4129},
 							name: "_",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 117, col: 85, offset: 2678},
+							pos:  position{line: 161, col: 85, offset: 4131},
 							name: "NUMBER",
 						},
 					},
@@ -727,37 +956,37 @@ var g = &grammar{
 		},
 		{
 			name: "GoodsTribe",
-			pos:  position{line: 122, col: 1, offset: 2721},
+			pos:  position{line: 166, col: 1, offset: 4174},
 			expr: &actionExpr{
-				pos: position{line: 122, col: 15, offset: 2735},
+				pos: position{line: 166, col: 15, offset: 4188},
 				run: (*parser).callonGoodsTribe1,
 				expr: &seqExpr{
-					pos: position{line: 122, col: 15, offset: 2735},
+					pos: position{line: 166, col: 15, offset: 4188},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 122, col: 15, offset: 2735},
+							pos:        position{line: 166, col: 15, offset: 4188},
 							val:        "Goods Tribe:",
 							ignoreCase: false,
 							want:       "\"Goods Tribe:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 122, col: 30, offset: 2750},
+							pos:  position{line: 166, col: 30, offset: 4203},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 122, col: 32, offset: 2752},
+							pos:   position{line: 166, col: 32, offset: 4205},
 							label: "id",
 							expr: &choiceExpr{
-								pos: position{line: 122, col: 36, offset: 2756},
+								pos: position{line: 166, col: 36, offset: 4209},
 								alternatives: []any{
 									&litMatcher{
-										pos:        position{line: 122, col: 36, offset: 2756},
+										pos:        position{line: 166, col: 36, offset: 4209},
 										val:        "No GT",
 										ignoreCase: false,
 										want:       "\"No GT\"",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 122, col: 46, offset: 2766},
+										pos:  position{line: 166, col: 46, offset: 4219},
 										name: "TRIBEID",
 									},
 								},
@@ -769,70 +998,70 @@ var g = &grammar{
 		},
 		{
 			name: "DesiredCommodities",
-			pos:  position{line: 139, col: 1, offset: 3111},
+			pos:  position{line: 183, col: 1, offset: 4564},
 			expr: &actionExpr{
-				pos: position{line: 139, col: 23, offset: 3133},
+				pos: position{line: 183, col: 23, offset: 4586},
 				run: (*parser).callonDesiredCommodities1,
 				expr: &seqExpr{
-					pos: position{line: 139, col: 23, offset: 3133},
+					pos: position{line: 183, col: 23, offset: 4586},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 139, col: 23, offset: 3133},
+							pos:        position{line: 183, col: 23, offset: 4586},
 							val:        "Desired Commodities:",
 							ignoreCase: false,
 							want:       "\"Desired Commodities:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 139, col: 46, offset: 3156},
+							pos:  position{line: 183, col: 46, offset: 4609},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 139, col: 48, offset: 3158},
+							pos:        position{line: 183, col: 48, offset: 4611},
 							val:        "(1)",
 							ignoreCase: false,
 							want:       "\"(1)\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 139, col: 54, offset: 3164},
+							pos:  position{line: 183, col: 54, offset: 4617},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 139, col: 56, offset: 3166},
+							pos:   position{line: 183, col: 56, offset: 4619},
 							label: "c1",
 							expr: &ruleRefExpr{
-								pos:  position{line: 139, col: 59, offset: 3169},
+								pos:  position{line: 183, col: 59, offset: 4622},
 								name: "COMMODITY",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 139, col: 69, offset: 3179},
+							pos:  position{line: 183, col: 69, offset: 4632},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 139, col: 71, offset: 3181},
+							pos:        position{line: 183, col: 71, offset: 4634},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 139, col: 75, offset: 3185},
+							pos:  position{line: 183, col: 75, offset: 4638},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 139, col: 77, offset: 3187},
+							pos:        position{line: 183, col: 77, offset: 4640},
 							val:        "(2)",
 							ignoreCase: false,
 							want:       "\"(2)\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 139, col: 83, offset: 3193},
+							pos:  position{line: 183, col: 83, offset: 4646},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 139, col: 85, offset: 3195},
+							pos:   position{line: 183, col: 85, offset: 4648},
 							label: "c2",
 							expr: &ruleRefExpr{
-								pos:  position{line: 139, col: 88, offset: 3198},
+								pos:  position{line: 183, col: 88, offset: 4651},
 								name: "COMMODITY",
 							},
 						},
@@ -842,37 +1071,37 @@ var g = &grammar{
 		},
 		{
 			name: "GMNotes",
-			pos:  position{line: 145, col: 1, offset: 3290},
+			pos:  position{line: 189, col: 1, offset: 4743},
 			expr: &actionExpr{
-				pos: position{line: 145, col: 12, offset: 3301},
+				pos: position{line: 189, col: 12, offset: 4754},
 				run: (*parser).callonGMNotes1,
 				expr: &seqExpr{
-					pos: position{line: 145, col: 12, offset: 3301},
+					pos: position{line: 189, col: 12, offset: 4754},
 					exprs: []any{
 						&zeroOrMoreExpr{
-							pos: position{line: 145, col: 12, offset: 3301},
+							pos: position{line: 189, col: 12, offset: 4754},
 							expr: &seqExpr{
-								pos: position{line: 145, col: 13, offset: 3302},
+								pos: position{line: 189, col: 13, offset: 4755},
 								exprs: []any{
 									&notExpr{
-										pos: position{line: 145, col: 13, offset: 3302},
+										pos: position{line: 189, col: 13, offset: 4755},
 										expr: &litMatcher{
-											pos:        position{line: 145, col: 14, offset: 3303},
+											pos:        position{line: 189, col: 14, offset: 4756},
 											val:        "Tribe Activities:",
 											ignoreCase: false,
 											want:       "\"Tribe Activities:\"",
 										},
 									},
 									&anyMatcher{
-										line: 145, col: 34, offset: 3323,
+										line: 189, col: 34, offset: 4776,
 									},
 								},
 							},
 						},
 						&andExpr{
-							pos: position{line: 145, col: 38, offset: 3327},
+							pos: position{line: 189, col: 38, offset: 4780},
 							expr: &litMatcher{
-								pos:        position{line: 145, col: 39, offset: 3328},
+								pos:        position{line: 189, col: 39, offset: 4781},
 								val:        "Tribe Activities:",
 								ignoreCase: false,
 								want:       "\"Tribe Activities:\"",
@@ -884,35 +1113,35 @@ var g = &grammar{
 		},
 		{
 			name: "TribeActivities",
-			pos:  position{line: 149, col: 1, offset: 3403},
+			pos:  position{line: 193, col: 1, offset: 4856},
 			expr: &actionExpr{
-				pos: position{line: 149, col: 20, offset: 3422},
+				pos: position{line: 193, col: 20, offset: 4875},
 				run: (*parser).callonTribeActivities1,
 				expr: &seqExpr{
-					pos: position{line: 149, col: 20, offset: 3422},
+					pos: position{line: 193, col: 20, offset: 4875},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 149, col: 20, offset: 3422},
+							pos:        position{line: 193, col: 20, offset: 4875},
 							val:        "Tribe Activities:",
 							ignoreCase: false,
 							want:       "\"Tribe Activities:\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 149, col: 40, offset: 3442},
+							pos: position{line: 193, col: 40, offset: 4895},
 							expr: &seqExpr{
-								pos: position{line: 149, col: 41, offset: 3443},
+								pos: position{line: 193, col: 41, offset: 4896},
 								exprs: []any{
 									&notExpr{
-										pos: position{line: 149, col: 41, offset: 3443},
+										pos: position{line: 193, col: 41, offset: 4896},
 										expr: &litMatcher{
-											pos:        position{line: 149, col: 42, offset: 3444},
+											pos:        position{line: 193, col: 42, offset: 4897},
 											val:        "Final Activities:",
 											ignoreCase: false,
 											want:       "\"Final Activities:\"",
 										},
 									},
 									&anyMatcher{
-										line: 149, col: 62, offset: 3464,
+										line: 193, col: 62, offset: 4917,
 									},
 								},
 							},
@@ -923,28 +1152,28 @@ var g = &grammar{
 		},
 		{
 			name: "FinalActivities",
-			pos:  position{line: 155, col: 1, offset: 3547},
+			pos:  position{line: 199, col: 1, offset: 5000},
 			expr: &actionExpr{
-				pos: position{line: 155, col: 20, offset: 3566},
+				pos: position{line: 199, col: 20, offset: 5019},
 				run: (*parser).callonFinalActivities1,
 				expr: &seqExpr{
-					pos: position{line: 155, col: 20, offset: 3566},
+					pos: position{line: 199, col: 20, offset: 5019},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 155, col: 20, offset: 3566},
+							pos:        position{line: 199, col: 20, offset: 5019},
 							val:        "Final Activities:",
 							ignoreCase: false,
 							want:       "\"Final Activities:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 155, col: 40, offset: 3586},
+							pos:  position{line: 199, col: 40, offset: 5039},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 155, col: 42, offset: 3588},
+							pos:   position{line: 199, col: 42, offset: 5041},
 							label: "bleet",
 							expr: &ruleRefExpr{
-								pos:  position{line: 155, col: 48, offset: 3594},
+								pos:  position{line: 199, col: 48, offset: 5047},
 								name: "untilTribeMovement",
 							},
 						},
@@ -954,28 +1183,28 @@ var g = &grammar{
 		},
 		{
 			name: "untilTribeMovement",
-			pos:  position{line: 165, col: 1, offset: 3793},
+			pos:  position{line: 209, col: 1, offset: 5246},
 			expr: &actionExpr{
-				pos: position{line: 165, col: 23, offset: 3815},
+				pos: position{line: 209, col: 23, offset: 5268},
 				run: (*parser).callonuntilTribeMovement1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 165, col: 23, offset: 3815},
+					pos: position{line: 209, col: 23, offset: 5268},
 					expr: &seqExpr{
-						pos: position{line: 165, col: 24, offset: 3816},
+						pos: position{line: 209, col: 24, offset: 5269},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 165, col: 24, offset: 3816},
+								pos: position{line: 209, col: 24, offset: 5269},
 								expr: &choiceExpr{
-									pos: position{line: 165, col: 26, offset: 3818},
+									pos: position{line: 209, col: 26, offset: 5271},
 									alternatives: []any{
 										&litMatcher{
-											pos:        position{line: 165, col: 26, offset: 3818},
+											pos:        position{line: 209, col: 26, offset: 5271},
 											val:        "Tribe Follows",
 											ignoreCase: false,
 											want:       "\"Tribe Follows\"",
 										},
 										&litMatcher{
-											pos:        position{line: 165, col: 44, offset: 3836},
+											pos:        position{line: 209, col: 44, offset: 5289},
 											val:        "Tribe Movement:",
 											ignoreCase: false,
 											want:       "\"Tribe Movement:\"",
@@ -984,7 +1213,7 @@ var g = &grammar{
 								},
 							},
 							&anyMatcher{
-								line: 165, col: 63, offset: 3855,
+								line: 209, col: 63, offset: 5308,
 							},
 						},
 					},
@@ -993,77 +1222,77 @@ var g = &grammar{
 		},
 		{
 			name: "TribeMovement",
-			pos:  position{line: 169, col: 1, offset: 3895},
+			pos:  position{line: 213, col: 1, offset: 5348},
 			expr: &choiceExpr{
-				pos: position{line: 169, col: 18, offset: 3912},
+				pos: position{line: 213, col: 18, offset: 5365},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 169, col: 18, offset: 3912},
+						pos: position{line: 213, col: 18, offset: 5365},
 						run: (*parser).callonTribeMovement2,
 						expr: &seqExpr{
-							pos: position{line: 169, col: 18, offset: 3912},
+							pos: position{line: 213, col: 18, offset: 5365},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 169, col: 18, offset: 3912},
+									pos:        position{line: 213, col: 18, offset: 5365},
 									val:        "Tribe Movement:",
 									ignoreCase: false,
 									want:       "\"Tribe Movement:\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 169, col: 36, offset: 3930},
+									pos:  position{line: 213, col: 36, offset: 5383},
 									name: "_",
 								},
 								&litMatcher{
-									pos:        position{line: 169, col: 38, offset: 3932},
+									pos:        position{line: 213, col: 38, offset: 5385},
 									val:        "Move",
 									ignoreCase: false,
 									want:       "\"Move\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 169, col: 45, offset: 3939},
+									pos:  position{line: 213, col: 45, offset: 5392},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 169, col: 47, offset: 3941},
+									pos:   position{line: 213, col: 47, offset: 5394},
 									label: "movesi",
 									expr: &ruleRefExpr{
-										pos:  position{line: 169, col: 54, offset: 3948},
+										pos:  position{line: 213, col: 54, offset: 5401},
 										name: "Moves",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 169, col: 60, offset: 3954},
+									pos:  position{line: 213, col: 60, offset: 5407},
 									name: "NL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 180, col: 5, offset: 4217},
+						pos: position{line: 224, col: 5, offset: 5670},
 						run: (*parser).callonTribeMovement11,
 						expr: &seqExpr{
-							pos: position{line: 180, col: 5, offset: 4217},
+							pos: position{line: 224, col: 5, offset: 5670},
 							exprs: []any{
 								&litMatcher{
-									pos:        position{line: 180, col: 5, offset: 4217},
+									pos:        position{line: 224, col: 5, offset: 5670},
 									val:        "Tribe Follows",
 									ignoreCase: false,
 									want:       "\"Tribe Follows\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 180, col: 21, offset: 4233},
+									pos:  position{line: 224, col: 21, offset: 5686},
 									name: "_",
 								},
 								&labeledExpr{
-									pos:   position{line: 180, col: 23, offset: 4235},
+									pos:   position{line: 224, col: 23, offset: 5688},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 180, col: 26, offset: 4238},
+										pos:  position{line: 224, col: 26, offset: 5691},
 										name: "UNITID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 180, col: 33, offset: 4245},
+									pos:  position{line: 224, col: 33, offset: 5698},
 									name: "NL",
 								},
 							},
@@ -1074,26 +1303,26 @@ var g = &grammar{
 		},
 		{
 			name: "Moves",
-			pos:  position{line: 184, col: 1, offset: 4306},
+			pos:  position{line: 228, col: 1, offset: 5759},
 			expr: &actionExpr{
-				pos: position{line: 184, col: 10, offset: 4315},
+				pos: position{line: 228, col: 10, offset: 5768},
 				run: (*parser).callonMoves1,
 				expr: &seqExpr{
-					pos: position{line: 184, col: 10, offset: 4315},
+					pos: position{line: 228, col: 10, offset: 5768},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 184, col: 10, offset: 4315},
+							pos:   position{line: 228, col: 10, offset: 5768},
 							label: "movesi",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 184, col: 17, offset: 4322},
+								pos: position{line: 228, col: 17, offset: 5775},
 								expr: &ruleRefExpr{
-									pos:  position{line: 184, col: 17, offset: 4322},
+									pos:  position{line: 228, col: 17, offset: 5775},
 									name: "validMove",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 184, col: 28, offset: 4333},
+							pos:  position{line: 228, col: 28, offset: 5786},
 							name: "NL",
 						},
 					},
@@ -1102,54 +1331,54 @@ var g = &grammar{
 		},
 		{
 			name: "validMove",
-			pos:  position{line: 201, col: 1, offset: 4742},
+			pos:  position{line: 245, col: 1, offset: 6195},
 			expr: &choiceExpr{
-				pos: position{line: 201, col: 14, offset: 4755},
+				pos: position{line: 245, col: 14, offset: 6208},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 201, col: 14, offset: 4755},
+						pos: position{line: 245, col: 14, offset: 6208},
 						run: (*parser).callonvalidMove2,
 						expr: &labeledExpr{
-							pos:   position{line: 201, col: 14, offset: 4755},
+							pos:   position{line: 245, col: 14, offset: 6208},
 							label: "move",
 							expr: &ruleRefExpr{
-								pos:  position{line: 201, col: 19, offset: 4760},
+								pos:  position{line: 245, col: 19, offset: 6213},
 								name: "successfulMove",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 206, col: 5, offset: 4895},
+						pos: position{line: 250, col: 5, offset: 6348},
 						run: (*parser).callonvalidMove5,
 						expr: &labeledExpr{
-							pos:   position{line: 206, col: 5, offset: 4895},
+							pos:   position{line: 250, col: 5, offset: 6348},
 							label: "move",
 							expr: &ruleRefExpr{
-								pos:  position{line: 206, col: 10, offset: 4900},
+								pos:  position{line: 250, col: 10, offset: 6353},
 								name: "blockedMove",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 211, col: 5, offset: 5029},
+						pos: position{line: 255, col: 5, offset: 6482},
 						run: (*parser).callonvalidMove8,
 						expr: &labeledExpr{
-							pos:   position{line: 211, col: 5, offset: 5029},
+							pos:   position{line: 255, col: 5, offset: 6482},
 							label: "move",
 							expr: &ruleRefExpr{
-								pos:  position{line: 211, col: 10, offset: 5034},
+								pos:  position{line: 255, col: 10, offset: 6487},
 								name: "notEnoughMP",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 216, col: 5, offset: 5163},
+						pos: position{line: 260, col: 5, offset: 6616},
 						run: (*parser).callonvalidMove11,
 						expr: &labeledExpr{
-							pos:   position{line: 216, col: 5, offset: 5163},
+							pos:   position{line: 260, col: 5, offset: 6616},
 							label: "move",
 							expr: &ruleRefExpr{
-								pos:  position{line: 216, col: 10, offset: 5168},
+								pos:  position{line: 260, col: 10, offset: 6621},
 								name: "stillMove",
 							},
 						},
@@ -1159,24 +1388,24 @@ var g = &grammar{
 		},
 		{
 			name: "blockedMove",
-			pos:  position{line: 223, col: 1, offset: 5292},
+			pos:  position{line: 269, col: 1, offset: 6875},
 			expr: &actionExpr{
-				pos: position{line: 223, col: 16, offset: 5307},
+				pos: position{line: 269, col: 16, offset: 6890},
 				run: (*parser).callonblockedMove1,
 				expr: &seqExpr{
-					pos: position{line: 223, col: 16, offset: 5307},
+					pos: position{line: 269, col: 16, offset: 6890},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 223, col: 16, offset: 5307},
+							pos:        position{line: 269, col: 16, offset: 6890},
 							val:        "Can't Move",
 							ignoreCase: false,
 							want:       "\"Can't Move\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 223, col: 29, offset: 5320},
+							pos:   position{line: 269, col: 29, offset: 6903},
 							label: "info",
 							expr: &ruleRefExpr{
-								pos:  position{line: 223, col: 34, offset: 5325},
+								pos:  position{line: 269, col: 34, offset: 6908},
 								name: "eatToEOL",
 							},
 						},
@@ -1186,31 +1415,31 @@ var g = &grammar{
 		},
 		{
 			name: "notEnoughMP",
-			pos:  position{line: 230, col: 1, offset: 5476},
+			pos:  position{line: 276, col: 1, offset: 7079},
 			expr: &actionExpr{
-				pos: position{line: 230, col: 16, offset: 5491},
+				pos: position{line: 276, col: 16, offset: 7094},
 				run: (*parser).callonnotEnoughMP1,
 				expr: &seqExpr{
-					pos: position{line: 230, col: 16, offset: 5491},
+					pos: position{line: 276, col: 16, offset: 7094},
 					exprs: []any{
 						&zeroOrMoreExpr{
-							pos: position{line: 230, col: 16, offset: 5491},
+							pos: position{line: 276, col: 16, offset: 7094},
 							expr: &ruleRefExpr{
-								pos:  position{line: 230, col: 16, offset: 5491},
+								pos:  position{line: 276, col: 16, offset: 7094},
 								name: "SPACE",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 230, col: 23, offset: 5498},
+							pos:        position{line: 276, col: 23, offset: 7101},
 							val:        "not enough",
 							ignoreCase: false,
 							want:       "\"not enough\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 230, col: 36, offset: 5511},
+							pos:   position{line: 276, col: 36, offset: 7114},
 							label: "info",
 							expr: &ruleRefExpr{
-								pos:  position{line: 230, col: 41, offset: 5516},
+								pos:  position{line: 276, col: 41, offset: 7119},
 								name: "eatToEOL",
 							},
 						},
@@ -1220,48 +1449,48 @@ var g = &grammar{
 		},
 		{
 			name: "successfulMove",
-			pos:  position{line: 237, col: 1, offset: 5667},
+			pos:  position{line: 283, col: 1, offset: 7290},
 			expr: &actionExpr{
-				pos: position{line: 237, col: 19, offset: 5685},
+				pos: position{line: 283, col: 19, offset: 7308},
 				run: (*parser).callonsuccessfulMove1,
 				expr: &seqExpr{
-					pos: position{line: 237, col: 19, offset: 5685},
+					pos: position{line: 283, col: 19, offset: 7308},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 237, col: 19, offset: 5685},
+							pos:   position{line: 283, col: 19, offset: 7308},
 							label: "direction",
 							expr: &ruleRefExpr{
-								pos:  position{line: 237, col: 29, offset: 5695},
+								pos:  position{line: 283, col: 29, offset: 7318},
 								name: "DIRECTION",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 237, col: 39, offset: 5705},
+							pos:        position{line: 283, col: 39, offset: 7328},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 237, col: 43, offset: 5709},
+							pos:   position{line: 283, col: 43, offset: 7332},
 							label: "terrain",
 							expr: &ruleRefExpr{
-								pos:  position{line: 237, col: 51, offset: 5717},
+								pos:  position{line: 283, col: 51, offset: 7340},
 								name: "TERRAIN",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 237, col: 59, offset: 5725},
+							pos:   position{line: 283, col: 59, offset: 7348},
 							label: "mi",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 237, col: 62, offset: 5728},
+								pos: position{line: 283, col: 62, offset: 7351},
 								expr: &ruleRefExpr{
-									pos:  position{line: 237, col: 62, offset: 5728},
+									pos:  position{line: 283, col: 62, offset: 7351},
 									name: "optMoveInfo",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 237, col: 75, offset: 5741},
+							pos:  position{line: 283, col: 75, offset: 7364},
 							name: "BACKSLASH",
 						},
 					},
@@ -1270,27 +1499,27 @@ var g = &grammar{
 		},
 		{
 			name: "stillMove",
-			pos:  position{line: 250, col: 1, offset: 5986},
+			pos:  position{line: 296, col: 1, offset: 7609},
 			expr: &actionExpr{
-				pos: position{line: 250, col: 14, offset: 5999},
+				pos: position{line: 296, col: 14, offset: 7622},
 				run: (*parser).callonstillMove1,
 				expr: &ruleRefExpr{
-					pos:  position{line: 250, col: 14, offset: 5999},
+					pos:  position{line: 296, col: 14, offset: 7622},
 					name: "BACKSLASH",
 				},
 			},
 		},
 		{
 			name: "optMoveInfo",
-			pos:  position{line: 255, col: 1, offset: 6090},
+			pos:  position{line: 301, col: 1, offset: 7713},
 			expr: &actionExpr{
-				pos: position{line: 255, col: 16, offset: 6105},
+				pos: position{line: 301, col: 16, offset: 7728},
 				run: (*parser).callonoptMoveInfo1,
 				expr: &labeledExpr{
-					pos:   position{line: 255, col: 16, offset: 6105},
+					pos:   position{line: 301, col: 16, offset: 7728},
 					label: "moveInfo",
 					expr: &ruleRefExpr{
-						pos:  position{line: 255, col: 25, offset: 6114},
+						pos:  position{line: 301, col: 25, offset: 7737},
 						name: "OPTMOVEINFO",
 					},
 				},
@@ -1298,33 +1527,33 @@ var g = &grammar{
 		},
 		{
 			name: "untilStatusOrScout",
-			pos:  position{line: 262, col: 1, offset: 6226},
+			pos:  position{line: 308, col: 1, offset: 7849},
 			expr: &actionExpr{
-				pos: position{line: 262, col: 23, offset: 6248},
+				pos: position{line: 308, col: 23, offset: 7871},
 				run: (*parser).callonuntilStatusOrScout1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 262, col: 23, offset: 6248},
+					pos: position{line: 308, col: 23, offset: 7871},
 					expr: &seqExpr{
-						pos: position{line: 262, col: 24, offset: 6249},
+						pos: position{line: 308, col: 24, offset: 7872},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 262, col: 24, offset: 6249},
+								pos: position{line: 308, col: 24, offset: 7872},
 								expr: &choiceExpr{
-									pos: position{line: 262, col: 26, offset: 6251},
+									pos: position{line: 308, col: 26, offset: 7874},
 									alternatives: []any{
 										&seqExpr{
-											pos: position{line: 262, col: 27, offset: 6252},
+											pos: position{line: 308, col: 27, offset: 7875},
 											exprs: []any{
 												&ruleRefExpr{
-													pos:  position{line: 262, col: 27, offset: 6252},
+													pos:  position{line: 308, col: 27, offset: 7875},
 													name: "UNITID",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 262, col: 34, offset: 6259},
+													pos:  position{line: 308, col: 34, offset: 7882},
 													name: "_",
 												},
 												&litMatcher{
-													pos:        position{line: 262, col: 36, offset: 6261},
+													pos:        position{line: 308, col: 36, offset: 7884},
 													val:        "Status:",
 													ignoreCase: false,
 													want:       "\"Status:\"",
@@ -1332,7 +1561,7 @@ var g = &grammar{
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 262, col: 49, offset: 6274},
+											pos:        position{line: 308, col: 49, offset: 7897},
 											val:        "Scout 1:",
 											ignoreCase: false,
 											want:       "\"Scout 1:\"",
@@ -1341,7 +1570,7 @@ var g = &grammar{
 								},
 							},
 							&anyMatcher{
-								line: 262, col: 61, offset: 6286,
+								line: 308, col: 61, offset: 7909,
 							},
 						},
 					},
@@ -1350,17 +1579,17 @@ var g = &grammar{
 		},
 		{
 			name: "ScoutActions",
-			pos:  position{line: 266, col: 1, offset: 6326},
+			pos:  position{line: 312, col: 1, offset: 7949},
 			expr: &actionExpr{
-				pos: position{line: 266, col: 17, offset: 6342},
+				pos: position{line: 312, col: 17, offset: 7965},
 				run: (*parser).callonScoutActions1,
 				expr: &labeledExpr{
-					pos:   position{line: 266, col: 17, offset: 6342},
+					pos:   position{line: 312, col: 17, offset: 7965},
 					label: "scoutsi",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 266, col: 25, offset: 6350},
+						pos: position{line: 312, col: 25, offset: 7973},
 						expr: &ruleRefExpr{
-							pos:  position{line: 266, col: 25, offset: 6350},
+							pos:  position{line: 312, col: 25, offset: 7973},
 							name: "ScoutMovement",
 						},
 					},
@@ -1369,64 +1598,64 @@ var g = &grammar{
 		},
 		{
 			name: "ScoutMovement",
-			pos:  position{line: 291, col: 1, offset: 7028},
+			pos:  position{line: 337, col: 1, offset: 8651},
 			expr: &actionExpr{
-				pos: position{line: 291, col: 18, offset: 7045},
+				pos: position{line: 337, col: 18, offset: 8668},
 				run: (*parser).callonScoutMovement1,
 				expr: &seqExpr{
-					pos: position{line: 291, col: 18, offset: 7045},
+					pos: position{line: 337, col: 18, offset: 8668},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 291, col: 18, offset: 7045},
+							pos:        position{line: 337, col: 18, offset: 8668},
 							val:        "Scout",
 							ignoreCase: false,
 							want:       "\"Scout\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 291, col: 26, offset: 7053},
+							pos:  position{line: 337, col: 26, offset: 8676},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 291, col: 28, offset: 7055},
+							pos:   position{line: 337, col: 28, offset: 8678},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 31, offset: 7058},
+								pos:  position{line: 337, col: 31, offset: 8681},
 								name: "NUMBER",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 291, col: 38, offset: 7065},
+							pos:  position{line: 337, col: 38, offset: 8688},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 291, col: 40, offset: 7067},
+							pos:        position{line: 337, col: 40, offset: 8690},
 							val:        ":Scout",
 							ignoreCase: false,
 							want:       "\":Scout\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 291, col: 49, offset: 7076},
+							pos: position{line: 337, col: 49, offset: 8699},
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 49, offset: 7076},
+								pos:  position{line: 337, col: 49, offset: 8699},
 								name: "SPACE",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 291, col: 56, offset: 7083},
+							pos:   position{line: 337, col: 56, offset: 8706},
 							label: "bleet",
 							expr: &ruleRefExpr{
-								pos:  position{line: 291, col: 62, offset: 7089},
+								pos:  position{line: 337, col: 62, offset: 8712},
 								name: "eatToSentinel",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 291, col: 76, offset: 7103},
+							pos:        position{line: 337, col: 76, offset: 8726},
 							val:        "$$$",
 							ignoreCase: false,
 							want:       "\"$$$\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 291, col: 82, offset: 7109},
+							pos:  position{line: 337, col: 82, offset: 8732},
 							name: "NL",
 						},
 					},
@@ -1435,52 +1664,52 @@ var g = &grammar{
 		},
 		{
 			name: "UnitStatus",
-			pos:  position{line: 311, col: 1, offset: 7692},
+			pos:  position{line: 357, col: 1, offset: 9315},
 			expr: &actionExpr{
-				pos: position{line: 311, col: 15, offset: 7706},
+				pos: position{line: 357, col: 15, offset: 9329},
 				run: (*parser).callonUnitStatus1,
 				expr: &seqExpr{
-					pos: position{line: 311, col: 15, offset: 7706},
+					pos: position{line: 357, col: 15, offset: 9329},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 311, col: 15, offset: 7706},
+							pos:   position{line: 357, col: 15, offset: 9329},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 311, col: 18, offset: 7709},
+								pos:  position{line: 357, col: 18, offset: 9332},
 								name: "UNITID",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 311, col: 25, offset: 7716},
+							pos:  position{line: 357, col: 25, offset: 9339},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 311, col: 27, offset: 7718},
+							pos:        position{line: 357, col: 27, offset: 9341},
 							val:        "Status:",
 							ignoreCase: false,
 							want:       "\"Status:\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 311, col: 37, offset: 7728},
+							pos:  position{line: 357, col: 37, offset: 9351},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 311, col: 39, offset: 7730},
+							pos:   position{line: 357, col: 39, offset: 9353},
 							label: "terrain",
 							expr: &ruleRefExpr{
-								pos:  position{line: 311, col: 47, offset: 7738},
+								pos:  position{line: 357, col: 47, offset: 9361},
 								name: "TERRAIN",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 311, col: 55, offset: 7746},
+							pos:  position{line: 357, col: 55, offset: 9369},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 311, col: 57, offset: 7748},
+							pos:   position{line: 357, col: 57, offset: 9371},
 							label: "bleet",
 							expr: &ruleRefExpr{
-								pos:  position{line: 311, col: 63, offset: 7754},
+								pos:  position{line: 357, col: 63, offset: 9377},
 								name: "untilHumans",
 							},
 						},
@@ -1490,26 +1719,26 @@ var g = &grammar{
 		},
 		{
 			name: "untilHumans",
-			pos:  position{line: 319, col: 1, offset: 7896},
+			pos:  position{line: 365, col: 1, offset: 9519},
 			expr: &actionExpr{
-				pos: position{line: 319, col: 16, offset: 7911},
+				pos: position{line: 365, col: 16, offset: 9534},
 				run: (*parser).callonuntilHumans1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 319, col: 16, offset: 7911},
+					pos: position{line: 365, col: 16, offset: 9534},
 					expr: &seqExpr{
-						pos: position{line: 319, col: 17, offset: 7912},
+						pos: position{line: 365, col: 17, offset: 9535},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 319, col: 17, offset: 7912},
+								pos: position{line: 365, col: 17, offset: 9535},
 								expr: &litMatcher{
-									pos:        position{line: 319, col: 18, offset: 7913},
+									pos:        position{line: 365, col: 18, offset: 9536},
 									val:        "Humans",
 									ignoreCase: false,
 									want:       "\"Humans\"",
 								},
 							},
 							&anyMatcher{
-								line: 319, col: 27, offset: 7922,
+								line: 365, col: 27, offset: 9545,
 							},
 						},
 					},
@@ -1518,116 +1747,116 @@ var g = &grammar{
 		},
 		{
 			name: "Humans",
-			pos:  position{line: 323, col: 1, offset: 7962},
+			pos:  position{line: 369, col: 1, offset: 9585},
 			expr: &actionExpr{
-				pos: position{line: 323, col: 11, offset: 7972},
+				pos: position{line: 369, col: 11, offset: 9595},
 				run: (*parser).callonHumans1,
 				expr: &seqExpr{
-					pos: position{line: 323, col: 11, offset: 7972},
+					pos: position{line: 369, col: 11, offset: 9595},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 323, col: 11, offset: 7972},
+							pos:        position{line: 369, col: 11, offset: 9595},
 							val:        "Humans",
 							ignoreCase: false,
 							want:       "\"Humans\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 323, col: 20, offset: 7981},
+							pos:  position{line: 369, col: 20, offset: 9604},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 324, col: 3, offset: 7985},
+							pos:        position{line: 370, col: 3, offset: 9608},
 							val:        "People",
 							ignoreCase: false,
 							want:       "\"People\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 324, col: 12, offset: 7994},
+							pos:  position{line: 370, col: 12, offset: 9617},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 324, col: 14, offset: 7996},
+							pos:   position{line: 370, col: 14, offset: 9619},
 							label: "totalPeople",
 							expr: &ruleRefExpr{
-								pos:  position{line: 324, col: 26, offset: 8008},
+								pos:  position{line: 370, col: 26, offset: 9631},
 								name: "NUMBER",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 324, col: 33, offset: 8015},
+							pos:  position{line: 370, col: 33, offset: 9638},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 325, col: 3, offset: 8019},
+							pos:        position{line: 371, col: 3, offset: 9642},
 							val:        "Warriors",
 							ignoreCase: false,
 							want:       "\"Warriors\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 325, col: 14, offset: 8030},
+							pos:  position{line: 371, col: 14, offset: 9653},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 325, col: 16, offset: 8032},
+							pos:   position{line: 371, col: 16, offset: 9655},
 							label: "warriors",
 							expr: &ruleRefExpr{
-								pos:  position{line: 325, col: 25, offset: 8041},
+								pos:  position{line: 371, col: 25, offset: 9664},
 								name: "NUMBER",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 325, col: 32, offset: 8048},
+							pos:  position{line: 371, col: 32, offset: 9671},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 326, col: 3, offset: 8052},
+							pos:        position{line: 372, col: 3, offset: 9675},
 							val:        "Actives",
 							ignoreCase: false,
 							want:       "\"Actives\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 326, col: 13, offset: 8062},
+							pos:  position{line: 372, col: 13, offset: 9685},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 326, col: 15, offset: 8064},
+							pos:   position{line: 372, col: 15, offset: 9687},
 							label: "active",
 							expr: &ruleRefExpr{
-								pos:  position{line: 326, col: 22, offset: 8071},
+								pos:  position{line: 372, col: 22, offset: 9694},
 								name: "NUMBER",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 326, col: 29, offset: 8078},
+							pos:  position{line: 372, col: 29, offset: 9701},
 							name: "_",
 						},
 						&litMatcher{
-							pos:        position{line: 327, col: 3, offset: 8082},
+							pos:        position{line: 373, col: 3, offset: 9705},
 							val:        "Inactives",
 							ignoreCase: false,
 							want:       "\"Inactives\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 327, col: 15, offset: 8094},
+							pos:  position{line: 373, col: 15, offset: 9717},
 							name: "_",
 						},
 						&labeledExpr{
-							pos:   position{line: 327, col: 17, offset: 8096},
+							pos:   position{line: 373, col: 17, offset: 9719},
 							label: "inactive",
 							expr: &ruleRefExpr{
-								pos:  position{line: 327, col: 26, offset: 8105},
+								pos:  position{line: 373, col: 26, offset: 9728},
 								name: "NUMBER",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 328, col: 3, offset: 8114},
+							pos: position{line: 374, col: 3, offset: 9737},
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 3, offset: 8114},
+								pos:  position{line: 374, col: 3, offset: 9737},
 								name: "SPACE",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 10, offset: 8121},
+							pos:        position{line: 374, col: 10, offset: 9744},
 							val:        "\n\n",
 							ignoreCase: false,
 							want:       "\"\\n\\n\"",
@@ -1638,58 +1867,58 @@ var g = &grammar{
 		},
 		{
 			name: "Possessions",
-			pos:  position{line: 347, col: 1, offset: 8600},
+			pos:  position{line: 393, col: 1, offset: 10223},
 			expr: &actionExpr{
-				pos: position{line: 347, col: 16, offset: 8615},
+				pos: position{line: 393, col: 16, offset: 10238},
 				run: (*parser).callonPossessions1,
 				expr: &seqExpr{
-					pos: position{line: 347, col: 16, offset: 8615},
+					pos: position{line: 393, col: 16, offset: 10238},
 					exprs: []any{
 						&labeledExpr{
-							pos:   position{line: 347, col: 16, offset: 8615},
+							pos:   position{line: 393, col: 16, offset: 10238},
 							label: "animals",
 							expr: &ruleRefExpr{
-								pos:  position{line: 347, col: 24, offset: 8623},
+								pos:  position{line: 393, col: 24, offset: 10246},
 								name: "Animals",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 32, offset: 8631},
+							pos:   position{line: 393, col: 32, offset: 10254},
 							label: "minerals",
 							expr: &ruleRefExpr{
-								pos:  position{line: 347, col: 41, offset: 8640},
+								pos:  position{line: 393, col: 41, offset: 10263},
 								name: "Minerals",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 50, offset: 8649},
+							pos:   position{line: 393, col: 50, offset: 10272},
 							label: "warEquipment",
 							expr: &ruleRefExpr{
-								pos:  position{line: 347, col: 63, offset: 8662},
+								pos:  position{line: 393, col: 63, offset: 10285},
 								name: "WarEquipment",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 76, offset: 8675},
+							pos:   position{line: 393, col: 76, offset: 10298},
 							label: "finishedGoods",
 							expr: &ruleRefExpr{
-								pos:  position{line: 347, col: 90, offset: 8689},
+								pos:  position{line: 393, col: 90, offset: 10312},
 								name: "FinishedGoods",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 104, offset: 8703},
+							pos:   position{line: 393, col: 104, offset: 10326},
 							label: "rawMaterials",
 							expr: &ruleRefExpr{
-								pos:  position{line: 347, col: 117, offset: 8716},
+								pos:  position{line: 393, col: 117, offset: 10339},
 								name: "RawMaterials",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 347, col: 130, offset: 8729},
+							pos:   position{line: 393, col: 130, offset: 10352},
 							label: "ships",
 							expr: &ruleRefExpr{
-								pos:  position{line: 347, col: 136, offset: 8735},
+								pos:  position{line: 393, col: 136, offset: 10358},
 								name: "Ships",
 							},
 						},
@@ -1699,480 +1928,1283 @@ var g = &grammar{
 		},
 		{
 			name: "Animals",
-			pos:  position{line: 358, col: 1, offset: 9042},
+			pos:  position{line: 404, col: 1, offset: 10665},
 			expr: &actionExpr{
-				pos: position{line: 358, col: 12, offset: 9053},
+				pos: position{line: 404, col: 12, offset: 10676},
 				run: (*parser).callonAnimals1,
 				expr: &seqExpr{
-					pos: position{line: 358, col: 12, offset: 9053},
+					pos: position{line: 404, col: 12, offset: 10676},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 358, col: 12, offset: 9053},
+							pos:        position{line: 404, col: 12, offset: 10676},
 							val:        "Animals",
 							ignoreCase: false,
 							want:       "\"Animals\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 358, col: 22, offset: 9063},
-							expr: &seqExpr{
-								pos: position{line: 358, col: 23, offset: 9064},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 358, col: 23, offset: 9064},
-										expr: &litMatcher{
-											pos:        position{line: 358, col: 24, offset: 9065},
-											val:        "Minerals",
-											ignoreCase: false,
-											want:       "\"Minerals\"",
-										},
-									},
-									&anyMatcher{
-										line: 358, col: 35, offset: 9076,
-									},
+						&ruleRefExpr{
+							pos:  position{line: 404, col: 22, offset: 10686},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 404, col: 24, offset: 10688},
+							label: "itemsi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 404, col: 31, offset: 10695},
+								expr: &ruleRefExpr{
+									pos:  position{line: 404, col: 31, offset: 10695},
+									name: "itemQuantity",
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 404, col: 45, offset: 10709},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 404, col: 51, offset: 10715},
+								name: "untilMinerals",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "Minerals",
-			pos:  position{line: 364, col: 1, offset: 9151},
+			pos:  position{line: 411, col: 1, offset: 10849},
 			expr: &actionExpr{
-				pos: position{line: 364, col: 13, offset: 9163},
+				pos: position{line: 411, col: 13, offset: 10861},
 				run: (*parser).callonMinerals1,
 				expr: &seqExpr{
-					pos: position{line: 364, col: 13, offset: 9163},
+					pos: position{line: 411, col: 13, offset: 10861},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 364, col: 13, offset: 9163},
+							pos:        position{line: 411, col: 13, offset: 10861},
 							val:        "Minerals",
 							ignoreCase: false,
 							want:       "\"Minerals\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 364, col: 24, offset: 9174},
-							expr: &seqExpr{
-								pos: position{line: 364, col: 25, offset: 9175},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 364, col: 25, offset: 9175},
-										expr: &litMatcher{
-											pos:        position{line: 364, col: 26, offset: 9176},
-											val:        "War Equipment",
-											ignoreCase: false,
-											want:       "\"War Equipment\"",
-										},
-									},
-									&anyMatcher{
-										line: 364, col: 42, offset: 9192,
-									},
+						&ruleRefExpr{
+							pos:  position{line: 411, col: 24, offset: 10872},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 411, col: 26, offset: 10874},
+							label: "itemsi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 411, col: 33, offset: 10881},
+								expr: &ruleRefExpr{
+									pos:  position{line: 411, col: 33, offset: 10881},
+									name: "itemQuantity",
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 411, col: 47, offset: 10895},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 411, col: 53, offset: 10901},
+								name: "untilWarEquipment",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "WarEquipment",
-			pos:  position{line: 370, col: 1, offset: 9268},
+			pos:  position{line: 418, col: 1, offset: 11040},
 			expr: &actionExpr{
-				pos: position{line: 370, col: 17, offset: 9284},
+				pos: position{line: 418, col: 17, offset: 11056},
 				run: (*parser).callonWarEquipment1,
 				expr: &seqExpr{
-					pos: position{line: 370, col: 17, offset: 9284},
+					pos: position{line: 418, col: 17, offset: 11056},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 370, col: 17, offset: 9284},
+							pos:        position{line: 418, col: 17, offset: 11056},
 							val:        "War Equipment",
 							ignoreCase: false,
 							want:       "\"War Equipment\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 370, col: 33, offset: 9300},
-							expr: &seqExpr{
-								pos: position{line: 370, col: 34, offset: 9301},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 370, col: 34, offset: 9301},
-										expr: &litMatcher{
-											pos:        position{line: 370, col: 35, offset: 9302},
-											val:        "Finished Goods",
-											ignoreCase: false,
-											want:       "\"Finished Goods\"",
-										},
-									},
-									&anyMatcher{
-										line: 370, col: 52, offset: 9319,
-									},
+						&ruleRefExpr{
+							pos:  position{line: 418, col: 33, offset: 11072},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 418, col: 35, offset: 11074},
+							label: "itemsi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 418, col: 42, offset: 11081},
+								expr: &ruleRefExpr{
+									pos:  position{line: 418, col: 42, offset: 11081},
+									name: "itemQuantity",
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 418, col: 56, offset: 11095},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 418, col: 62, offset: 11101},
+								name: "untilFinishedGoods",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "FinishedGoods",
-			pos:  position{line: 376, col: 1, offset: 9399},
+			pos:  position{line: 425, col: 1, offset: 11245},
 			expr: &actionExpr{
-				pos: position{line: 376, col: 18, offset: 9416},
+				pos: position{line: 425, col: 18, offset: 11262},
 				run: (*parser).callonFinishedGoods1,
 				expr: &seqExpr{
-					pos: position{line: 376, col: 18, offset: 9416},
+					pos: position{line: 425, col: 18, offset: 11262},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 376, col: 18, offset: 9416},
+							pos:        position{line: 425, col: 18, offset: 11262},
 							val:        "Finished Goods",
 							ignoreCase: false,
 							want:       "\"Finished Goods\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 376, col: 35, offset: 9433},
-							expr: &seqExpr{
-								pos: position{line: 376, col: 36, offset: 9434},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 376, col: 36, offset: 9434},
-										expr: &litMatcher{
-											pos:        position{line: 376, col: 37, offset: 9435},
-											val:        "Raw Materials",
-											ignoreCase: false,
-											want:       "\"Raw Materials\"",
-										},
-									},
-									&anyMatcher{
-										line: 376, col: 53, offset: 9451,
-									},
+						&ruleRefExpr{
+							pos:  position{line: 425, col: 35, offset: 11279},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 425, col: 37, offset: 11281},
+							label: "itemsi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 425, col: 44, offset: 11288},
+								expr: &ruleRefExpr{
+									pos:  position{line: 425, col: 44, offset: 11288},
+									name: "itemQuantity",
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 425, col: 58, offset: 11302},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 425, col: 64, offset: 11308},
+								name: "untilRawMaterials",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "RawMaterials",
-			pos:  position{line: 382, col: 1, offset: 9532},
+			pos:  position{line: 432, col: 1, offset: 11452},
 			expr: &actionExpr{
-				pos: position{line: 382, col: 17, offset: 9548},
+				pos: position{line: 432, col: 17, offset: 11468},
 				run: (*parser).callonRawMaterials1,
 				expr: &seqExpr{
-					pos: position{line: 382, col: 17, offset: 9548},
+					pos: position{line: 432, col: 17, offset: 11468},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 382, col: 17, offset: 9548},
+							pos:        position{line: 432, col: 17, offset: 11468},
 							val:        "Raw Materials",
 							ignoreCase: false,
 							want:       "\"Raw Materials\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 382, col: 33, offset: 9564},
-							expr: &seqExpr{
-								pos: position{line: 382, col: 34, offset: 9565},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 382, col: 34, offset: 9565},
-										expr: &litMatcher{
-											pos:        position{line: 382, col: 35, offset: 9566},
-											val:        "Ships",
-											ignoreCase: false,
-											want:       "\"Ships\"",
-										},
-									},
-									&anyMatcher{
-										line: 382, col: 43, offset: 9574,
-									},
+						&ruleRefExpr{
+							pos:  position{line: 432, col: 33, offset: 11484},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 432, col: 35, offset: 11486},
+							label: "itemsi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 432, col: 42, offset: 11493},
+								expr: &ruleRefExpr{
+									pos:  position{line: 432, col: 42, offset: 11493},
+									name: "itemQuantity",
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 432, col: 56, offset: 11507},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 432, col: 62, offset: 11513},
+								name: "untilShips",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "Ships",
-			pos:  position{line: 388, col: 1, offset: 9654},
+			pos:  position{line: 439, col: 1, offset: 11649},
 			expr: &actionExpr{
-				pos: position{line: 388, col: 10, offset: 9663},
+				pos: position{line: 439, col: 10, offset: 11658},
 				run: (*parser).callonShips1,
 				expr: &seqExpr{
-					pos: position{line: 388, col: 10, offset: 9663},
+					pos: position{line: 439, col: 10, offset: 11658},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 388, col: 10, offset: 9663},
+							pos:        position{line: 439, col: 10, offset: 11658},
 							val:        "Ships",
 							ignoreCase: false,
 							want:       "\"Ships\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 388, col: 18, offset: 9671},
+						&ruleRefExpr{
+							pos:  position{line: 439, col: 18, offset: 11666},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 439, col: 20, offset: 11668},
+							label: "itemsi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 439, col: 27, offset: 11675},
+								expr: &ruleRefExpr{
+									pos:  position{line: 439, col: 27, offset: 11675},
+									name: "itemQuantity",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 439, col: 41, offset: 11689},
 							expr: &seqExpr{
-								pos: position{line: 388, col: 19, offset: 9672},
+								pos: position{line: 439, col: 42, offset: 11690},
 								exprs: []any{
-									&notExpr{
-										pos: position{line: 388, col: 19, offset: 9672},
-										expr: &litMatcher{
-											pos:        position{line: 388, col: 20, offset: 9673},
-											val:        "Skills:",
-											ignoreCase: false,
-											want:       "\"Skills:\"",
-										},
+									&litMatcher{
+										pos:        position{line: 439, col: 42, offset: 11690},
+										val:        "None",
+										ignoreCase: false,
+										want:       "\"None\"",
 									},
-									&anyMatcher{
-										line: 388, col: 30, offset: 9683,
+									&ruleRefExpr{
+										pos:  position{line: 439, col: 49, offset: 11697},
+										name: "_",
 									},
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 439, col: 53, offset: 11701},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 439, col: 59, offset: 11707},
+								name: "untilSkills",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Skills",
-			pos:  position{line: 394, col: 1, offset: 9756},
+			name: "itemQuantity",
+			pos:  position{line: 448, col: 1, offset: 11963},
 			expr: &actionExpr{
-				pos: position{line: 394, col: 11, offset: 9766},
-				run: (*parser).callonSkills1,
+				pos: position{line: 448, col: 17, offset: 11979},
+				run: (*parser).callonitemQuantity1,
 				expr: &seqExpr{
-					pos: position{line: 394, col: 11, offset: 9766},
+					pos: position{line: 448, col: 17, offset: 11979},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 394, col: 11, offset: 9766},
-							val:        "Skills:",
-							ignoreCase: false,
-							want:       "\"Skills:\"",
+						&notExpr{
+							pos: position{line: 448, col: 17, offset: 11979},
+							expr: &ruleRefExpr{
+								pos:  position{line: 448, col: 18, offset: 11980},
+								name: "possessionHeading",
+							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 394, col: 21, offset: 9776},
-							expr: &seqExpr{
-								pos: position{line: 394, col: 22, offset: 9777},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 394, col: 22, offset: 9777},
-										expr: &litMatcher{
-											pos:        position{line: 394, col: 23, offset: 9778},
-											val:        "Morale :",
+						&labeledExpr{
+							pos:   position{line: 448, col: 36, offset: 11998},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 448, col: 41, offset: 12003},
+								name: "ITEMNAME",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 448, col: 50, offset: 12012},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 448, col: 52, offset: 12014},
+							label: "qty",
+							expr: &ruleRefExpr{
+								pos:  position{line: 448, col: 56, offset: 12018},
+								name: "QUANTITY",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 448, col: 65, offset: 12027},
+							name: "_",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 448, col: 67, offset: 12029},
+							expr: &litMatcher{
+								pos:        position{line: 448, col: 67, offset: 12029},
+								val:        ",",
+								ignoreCase: false,
+								want:       "\",\"",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 448, col: 72, offset: 12034},
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "possessionHeading",
+			pos:  position{line: 453, col: 1, offset: 12127},
+			expr: &choiceExpr{
+				pos: position{line: 453, col: 22, offset: 12148},
+				alternatives: []any{
+					&litMatcher{
+						pos:        position{line: 453, col: 22, offset: 12148},
+						val:        "Animals",
+						ignoreCase: false,
+						want:       "\"Animals\"",
+					},
+					&litMatcher{
+						pos:        position{line: 453, col: 34, offset: 12160},
+						val:        "Minerals",
+						ignoreCase: false,
+						want:       "\"Minerals\"",
+					},
+					&litMatcher{
+						pos:        position{line: 453, col: 47, offset: 12173},
+						val:        "War Equipment",
+						ignoreCase: false,
+						want:       "\"War Equipment\"",
+					},
+					&litMatcher{
+						pos:        position{line: 453, col: 65, offset: 12191},
+						val:        "Finished Goods",
+						ignoreCase: false,
+						want:       "\"Finished Goods\"",
+					},
+					&litMatcher{
+						pos:        position{line: 453, col: 84, offset: 12210},
+						val:        "Raw Materials",
+						ignoreCase: false,
+						want:       "\"Raw Materials\"",
+					},
+					&litMatcher{
+						pos:        position{line: 453, col: 102, offset: 12228},
+						val:        "Ships",
+						ignoreCase: false,
+						want:       "\"Ships\"",
+					},
+					&litMatcher{
+						pos:        position{line: 453, col: 112, offset: 12238},
+						val:        "Skills:",
+						ignoreCase: false,
+						want:       "\"Skills:\"",
+					},
+				},
+			},
+		},
+		{
+			name: "untilMinerals",
+			pos:  position{line: 455, col: 1, offset: 12249},
+			expr: &actionExpr{
+				pos: position{line: 455, col: 18, offset: 12266},
+				run: (*parser).callonuntilMinerals1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 455, col: 18, offset: 12266},
+					expr: &seqExpr{
+						pos: position{line: 455, col: 19, offset: 12267},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 455, col: 19, offset: 12267},
+								expr: &litMatcher{
+									pos:        position{line: 455, col: 20, offset: 12268},
+									val:        "Minerals",
+									ignoreCase: false,
+									want:       "\"Minerals\"",
+								},
+							},
+							&anyMatcher{
+								line: 455, col: 31, offset: 12279,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilWarEquipment",
+			pos:  position{line: 459, col: 1, offset: 12319},
+			expr: &actionExpr{
+				pos: position{line: 459, col: 22, offset: 12340},
+				run: (*parser).callonuntilWarEquipment1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 459, col: 22, offset: 12340},
+					expr: &seqExpr{
+						pos: position{line: 459, col: 23, offset: 12341},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 459, col: 23, offset: 12341},
+								expr: &litMatcher{
+									pos:        position{line: 459, col: 24, offset: 12342},
+									val:        "War Equipment",
+									ignoreCase: false,
+									want:       "\"War Equipment\"",
+								},
+							},
+							&anyMatcher{
+								line: 459, col: 40, offset: 12358,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilFinishedGoods",
+			pos:  position{line: 463, col: 1, offset: 12398},
+			expr: &actionExpr{
+				pos: position{line: 463, col: 23, offset: 12420},
+				run: (*parser).callonuntilFinishedGoods1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 463, col: 23, offset: 12420},
+					expr: &seqExpr{
+						pos: position{line: 463, col: 24, offset: 12421},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 463, col: 24, offset: 12421},
+								expr: &litMatcher{
+									pos:        position{line: 463, col: 25, offset: 12422},
+									val:        "Finished Goods",
+									ignoreCase: false,
+									want:       "\"Finished Goods\"",
+								},
+							},
+							&anyMatcher{
+								line: 463, col: 42, offset: 12439,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilRawMaterials",
+			pos:  position{line: 467, col: 1, offset: 12479},
+			expr: &actionExpr{
+				pos: position{line: 467, col: 22, offset: 12500},
+				run: (*parser).callonuntilRawMaterials1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 467, col: 22, offset: 12500},
+					expr: &seqExpr{
+						pos: position{line: 467, col: 23, offset: 12501},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 467, col: 23, offset: 12501},
+								expr: &litMatcher{
+									pos:        position{line: 467, col: 24, offset: 12502},
+									val:        "Raw Materials",
+									ignoreCase: false,
+									want:       "\"Raw Materials\"",
+								},
+							},
+							&anyMatcher{
+								line: 467, col: 40, offset: 12518,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilShips",
+			pos:  position{line: 471, col: 1, offset: 12558},
+			expr: &actionExpr{
+				pos: position{line: 471, col: 15, offset: 12572},
+				run: (*parser).callonuntilShips1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 471, col: 15, offset: 12572},
+					expr: &seqExpr{
+						pos: position{line: 471, col: 16, offset: 12573},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 471, col: 16, offset: 12573},
+								expr: &litMatcher{
+									pos:        position{line: 471, col: 17, offset: 12574},
+									val:        "Ships",
+									ignoreCase: false,
+									want:       "\"Ships\"",
+								},
+							},
+							&anyMatcher{
+								line: 471, col: 25, offset: 12582,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilSkills",
+			pos:  position{line: 475, col: 1, offset: 12622},
+			expr: &actionExpr{
+				pos: position{line: 475, col: 16, offset: 12637},
+				run: (*parser).callonuntilSkills1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 475, col: 16, offset: 12637},
+					expr: &seqExpr{
+						pos: position{line: 475, col: 17, offset: 12638},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 475, col: 17, offset: 12638},
+								expr: &litMatcher{
+									pos:        position{line: 475, col: 18, offset: 12639},
+									val:        "Skills:",
+									ignoreCase: false,
+									want:       "\"Skills:\"",
+								},
+							},
+							&anyMatcher{
+								line: 475, col: 28, offset: 12649,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Skills",
+			pos:  position{line: 479, col: 1, offset: 12689},
+			expr: &actionExpr{
+				pos: position{line: 479, col: 11, offset: 12699},
+				run: (*parser).callonSkills1,
+				expr: &seqExpr{
+					pos: position{line: 479, col: 11, offset: 12699},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 479, col: 11, offset: 12699},
+							val:        "Skills:",
+							ignoreCase: false,
+							want:       "\"Skills:\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 479, col: 21, offset: 12709},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 479, col: 23, offset: 12711},
+							label: "levelsi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 479, col: 31, offset: 12719},
+								expr: &ruleRefExpr{
+									pos:  position{line: 479, col: 31, offset: 12719},
+									name: "skillLevel",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 479, col: 43, offset: 12731},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 479, col: 49, offset: 12737},
+								name: "untilMorale",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "skillLevel",
+			pos:  position{line: 492, col: 1, offset: 13099},
+			expr: &actionExpr{
+				pos: position{line: 492, col: 15, offset: 13113},
+				run: (*parser).callonskillLevel1,
+				expr: &seqExpr{
+					pos: position{line: 492, col: 15, offset: 13113},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 492, col: 15, offset: 13113},
+							label: "code",
+							expr: &ruleRefExpr{
+								pos:  position{line: 492, col: 20, offset: 13118},
+								name: "SKILLCODE",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 492, col: 30, offset: 13128},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 492, col: 32, offset: 13130},
+							label: "level",
+							expr: &ruleRefExpr{
+								pos:  position{line: 492, col: 38, offset: 13136},
+								name: "NUMBER",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 492, col: 45, offset: 13143},
+							name: "_",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 492, col: 47, offset: 13145},
+							expr: &litMatcher{
+								pos:        position{line: 492, col: 47, offset: 13145},
+								val:        ",",
+								ignoreCase: false,
+								want:       "\",\"",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 492, col: 52, offset: 13150},
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilMorale",
+			pos:  position{line: 497, col: 1, offset: 13239},
+			expr: &actionExpr{
+				pos: position{line: 497, col: 16, offset: 13254},
+				run: (*parser).callonuntilMorale1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 497, col: 16, offset: 13254},
+					expr: &seqExpr{
+						pos: position{line: 497, col: 17, offset: 13255},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 497, col: 17, offset: 13255},
+								expr: &litMatcher{
+									pos:        position{line: 497, col: 18, offset: 13256},
+									val:        "Morale :",
+									ignoreCase: false,
+									want:       "\"Morale :\"",
+								},
+							},
+							&anyMatcher{
+								line: 497, col: 29, offset: 13267,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Morale",
+			pos:  position{line: 501, col: 1, offset: 13307},
+			expr: &actionExpr{
+				pos: position{line: 501, col: 11, offset: 13317},
+				run: (*parser).callonMorale1,
+				expr: &seqExpr{
+					pos: position{line: 501, col: 11, offset: 13317},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 501, col: 11, offset: 13317},
+							val:        "Morale :",
+							ignoreCase: false,
+							want:       "\"Morale :\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 501, col: 22, offset: 13328},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 501, col: 24, offset: 13330},
+							label: "value",
+							expr: &ruleRefExpr{
+								pos:  position{line: 501, col: 30, offset: 13336},
+								name: "NUMBER",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 501, col: 37, offset: 13343},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 501, col: 43, offset: 13349},
+								name: "untilWeight",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilWeight",
+			pos:  position{line: 511, col: 1, offset: 13572},
+			expr: &actionExpr{
+				pos: position{line: 511, col: 16, offset: 13587},
+				run: (*parser).callonuntilWeight1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 511, col: 16, offset: 13587},
+					expr: &seqExpr{
+						pos: position{line: 511, col: 17, offset: 13588},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 511, col: 17, offset: 13588},
+								expr: &litMatcher{
+									pos:        position{line: 511, col: 18, offset: 13589},
+									val:        "Weight:",
+									ignoreCase: false,
+									want:       "\"Weight:\"",
+								},
+							},
+							&anyMatcher{
+								line: 511, col: 28, offset: 13599,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Weight",
+			pos:  position{line: 515, col: 1, offset: 13639},
+			expr: &actionExpr{
+				pos: position{line: 515, col: 11, offset: 13649},
+				run: (*parser).callonWeight1,
+				expr: &seqExpr{
+					pos: position{line: 515, col: 11, offset: 13649},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 515, col: 11, offset: 13649},
+							val:        "Weight:",
+							ignoreCase: false,
+							want:       "\"Weight:\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 515, col: 21, offset: 13659},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 515, col: 23, offset: 13661},
+							label: "value",
+							expr: &ruleRefExpr{
+								pos:  position{line: 515, col: 29, offset: 13667},
+								name: "QUANTITY",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 515, col: 38, offset: 13676},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 515, col: 44, offset: 13682},
+								name: "untilTrucesOrFF",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilTrucesOrFF",
+			pos:  position{line: 525, col: 1, offset: 13888},
+			expr: &actionExpr{
+				pos: position{line: 525, col: 20, offset: 13907},
+				run: (*parser).callonuntilTrucesOrFF1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 525, col: 20, offset: 13907},
+					expr: &seqExpr{
+						pos: position{line: 525, col: 21, offset: 13908},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 525, col: 21, offset: 13908},
+								expr: &choiceExpr{
+									pos: position{line: 525, col: 23, offset: 13910},
+									alternatives: []any{
+										&ruleRefExpr{
+											pos:  position{line: 525, col: 23, offset: 13910},
+											name: "FF",
+										},
+										&litMatcher{
+											pos:        position{line: 525, col: 28, offset: 13915},
+											val:        "Truces :",
 											ignoreCase: false,
-											want:       "\"Morale :\"",
+											want:       "\"Truces :\"",
 										},
 									},
-									&anyMatcher{
-										line: 394, col: 34, offset: 9789,
-									},
 								},
 							},
+							&anyMatcher{
+								line: 525, col: 40, offset: 13927,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Truces",
+			pos:  position{line: 529, col: 1, offset: 13967},
+			expr: &actionExpr{
+				pos: position{line: 529, col: 11, offset: 13977},
+				run: (*parser).callonTruces1,
+				expr: &seqExpr{
+					pos: position{line: 529, col: 11, offset: 13977},
+					exprs: []any{
+						&litMatcher{
+							pos:        position{line: 529, col: 11, offset: 13977},
+							val:        "Truces :",
+							ignoreCase: false,
+							want:       "\"Truces :\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 529, col: 22, offset: 13988},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 529, col: 24, offset: 13990},
+							label: "trucesi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 529, col: 32, offset: 13998},
+								expr: &ruleRefExpr{
+									pos:  position{line: 529, col: 32, offset: 13998},
+									name: "truce",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 529, col: 39, offset: 14005},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 529, col: 45, offset: 14011},
+								name: "untilFF",
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "Morale",
-			pos:  position{line: 400, col: 1, offset: 9863},
+			name: "truce",
+			pos:  position{line: 541, col: 1, offset: 14341},
 			expr: &actionExpr{
-				pos: position{line: 400, col: 11, offset: 9873},
-				run: (*parser).callonMorale1,
+				pos: position{line: 541, col: 10, offset: 14350},
+				run: (*parser).callontruce1,
 				expr: &seqExpr{
-					pos: position{line: 400, col: 11, offset: 9873},
+					pos: position{line: 541, col: 10, offset: 14350},
 					exprs: []any{
-						&litMatcher{
-							pos:        position{line: 400, col: 11, offset: 9873},
-							val:        "Morale :",
-							ignoreCase: false,
-							want:       "\"Morale :\"",
+						&labeledExpr{
+							pos:   position{line: 541, col: 10, offset: 14350},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 541, col: 13, offset: 14353},
+								name: "UNITID",
+							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 400, col: 22, offset: 9884},
-							expr: &seqExpr{
-								pos: position{line: 400, col: 23, offset: 9885},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 400, col: 23, offset: 9885},
-										expr: &litMatcher{
-											pos:        position{line: 400, col: 24, offset: 9886},
-											val:        "Weight:",
-											ignoreCase: false,
-											want:       "\"Weight:\"",
-										},
-									},
-									&anyMatcher{
-										line: 400, col: 34, offset: 9896,
-									},
+							pos: position{line: 541, col: 20, offset: 14360},
+							expr: &ruleRefExpr{
+								pos:  position{line: 541, col: 20, offset: 14360},
+								name: "SPACE",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 541, col: 27, offset: 14367},
+							label: "note",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 541, col: 32, offset: 14372},
+								expr: &ruleRefExpr{
+									pos:  position{line: 541, col: 32, offset: 14372},
+									name: "truceNote",
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 541, col: 43, offset: 14383},
+							name: "_",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 541, col: 45, offset: 14385},
+							expr: &litMatcher{
+								pos:        position{line: 541, col: 45, offset: 14385},
+								val:        ",",
+								ignoreCase: false,
+								want:       "\",\"",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 541, col: 50, offset: 14390},
+							name: "_",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Weight",
-			pos:  position{line: 406, col: 1, offset: 9970},
+			name: "truceNote",
+			pos:  position{line: 549, col: 1, offset: 14508},
 			expr: &actionExpr{
-				pos: position{line: 406, col: 11, offset: 9980},
-				run: (*parser).callonWeight1,
+				pos: position{line: 549, col: 14, offset: 14521},
+				run: (*parser).callontruceNote1,
 				expr: &seqExpr{
-					pos: position{line: 406, col: 11, offset: 9980},
+					pos: position{line: 549, col: 14, offset: 14521},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 406, col: 11, offset: 9980},
-							val:        "Weight:",
+							pos:        position{line: 549, col: 14, offset: 14521},
+							val:        "(",
 							ignoreCase: false,
-							want:       "\"Weight:\"",
+							want:       "\"(\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 406, col: 21, offset: 9990},
+							pos: position{line: 549, col: 18, offset: 14525},
 							expr: &seqExpr{
-								pos: position{line: 406, col: 22, offset: 9991},
+								pos: position{line: 549, col: 19, offset: 14526},
 								exprs: []any{
 									&notExpr{
-										pos: position{line: 406, col: 22, offset: 9991},
+										pos: position{line: 549, col: 19, offset: 14526},
 										expr: &choiceExpr{
-											pos: position{line: 406, col: 24, offset: 9993},
+											pos: position{line: 549, col: 21, offset: 14528},
 											alternatives: []any{
-												&ruleRefExpr{
-													pos:  position{line: 406, col: 24, offset: 9993},
-													name: "FF",
-												},
 												&litMatcher{
-													pos:        position{line: 406, col: 29, offset: 9998},
-													val:        "Truces :",
+													pos:        position{line: 549, col: 21, offset: 14528},
+													val:        ")",
 													ignoreCase: false,
-													want:       "\"Truces :\"",
+													want:       "\")\"",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 549, col: 27, offset: 14534},
+													name: "NL",
 												},
 											},
 										},
 									},
 									&anyMatcher{
-										line: 406, col: 41, offset: 10010,
+										line: 549, col: 31, offset: 14538,
 									},
 								},
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 549, col: 35, offset: 14542},
+							val:        ")",
+							ignoreCase: false,
+							want:       "\")\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Truces",
-			pos:  position{line: 412, col: 1, offset: 10084},
+			name: "Transfers",
+			pos:  position{line: 555, col: 1, offset: 14749},
 			expr: &actionExpr{
-				pos: position{line: 412, col: 11, offset: 10094},
-				run: (*parser).callonTruces1,
+				pos: position{line: 555, col: 14, offset: 14762},
+				run: (*parser).callonTransfers1,
 				expr: &seqExpr{
-					pos: position{line: 412, col: 11, offset: 10094},
+					pos: position{line: 555, col: 14, offset: 14762},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 412, col: 11, offset: 10094},
-							val:        "Truces :",
+							pos:        position{line: 555, col: 14, offset: 14762},
+							val:        "Transfers",
 							ignoreCase: false,
-							want:       "\"Truces :\"",
+							want:       "\"Transfers\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 412, col: 22, offset: 10105},
-							expr: &seqExpr{
-								pos: position{line: 412, col: 23, offset: 10106},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 412, col: 23, offset: 10106},
-										expr: &ruleRefExpr{
-											pos:  position{line: 412, col: 24, offset: 10107},
-											name: "FF",
-										},
-									},
-									&anyMatcher{
-										line: 412, col: 27, offset: 10110,
-									},
+						&ruleRefExpr{
+							pos:  position{line: 555, col: 26, offset: 14774},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 555, col: 28, offset: 14776},
+							label: "transfersi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 555, col: 39, offset: 14787},
+								expr: &ruleRefExpr{
+									pos:  position{line: 555, col: 39, offset: 14787},
+									name: "transferLine",
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 555, col: 53, offset: 14801},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 555, col: 59, offset: 14807},
+								name: "untilFF",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Transfers",
-			pos:  position{line: 418, col: 1, offset: 10184},
+			name: "transferLine",
+			pos:  position{line: 566, col: 1, offset: 15076},
 			expr: &actionExpr{
-				pos: position{line: 418, col: 14, offset: 10197},
-				run: (*parser).callonTransfers1,
+				pos: position{line: 566, col: 17, offset: 15092},
+				run: (*parser).callontransferLine1,
 				expr: &seqExpr{
-					pos: position{line: 418, col: 14, offset: 10197},
+					pos: position{line: 566, col: 17, offset: 15092},
 					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 566, col: 17, offset: 15092},
+							label: "from",
+							expr: &ruleRefExpr{
+								pos:  position{line: 566, col: 22, offset: 15097},
+								name: "UNITID",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 566, col: 29, offset: 15104},
+							name: "_",
+						},
 						&litMatcher{
-							pos:        position{line: 418, col: 14, offset: 10197},
-							val:        "Transfers",
+							pos:        position{line: 566, col: 31, offset: 15106},
+							val:        "to",
 							ignoreCase: false,
-							want:       "\"Transfers\"",
+							want:       "\"to\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 418, col: 26, offset: 10209},
+						&ruleRefExpr{
+							pos:  position{line: 566, col: 36, offset: 15111},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 566, col: 38, offset: 15113},
+							label: "to",
+							expr: &ruleRefExpr{
+								pos:  position{line: 566, col: 41, offset: 15116},
+								name: "UNITID",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 566, col: 48, offset: 15123},
+							name: "_",
+						},
+						&litMatcher{
+							pos:        position{line: 566, col: 50, offset: 15125},
+							val:        ":",
+							ignoreCase: false,
+							want:       "\":\"",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 566, col: 54, offset: 15129},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 566, col: 56, offset: 15131},
+							label: "itemsi",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 566, col: 63, offset: 15138},
+								expr: &ruleRefExpr{
+									pos:  position{line: 566, col: 63, offset: 15138},
+									name: "transferItem",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "transferItem",
+			pos:  position{line: 577, col: 1, offset: 15492},
+			expr: &actionExpr{
+				pos: position{line: 577, col: 17, offset: 15508},
+				run: (*parser).callontransferItem1,
+				expr: &seqExpr{
+					pos: position{line: 577, col: 17, offset: 15508},
+					exprs: []any{
+						&notExpr{
+							pos: position{line: 577, col: 17, offset: 15508},
 							expr: &seqExpr{
-								pos: position{line: 418, col: 27, offset: 10210},
+								pos: position{line: 577, col: 19, offset: 15510},
 								exprs: []any{
-									&notExpr{
-										pos: position{line: 418, col: 27, offset: 10210},
-										expr: &ruleRefExpr{
-											pos:  position{line: 418, col: 28, offset: 10211},
-											name: "FF",
-										},
+									&ruleRefExpr{
+										pos:  position{line: 577, col: 19, offset: 15510},
+										name: "UNITID",
 									},
-									&anyMatcher{
-										line: 418, col: 31, offset: 10214,
+									&ruleRefExpr{
+										pos:  position{line: 577, col: 26, offset: 15517},
+										name: "_",
+									},
+									&litMatcher{
+										pos:        position{line: 577, col: 28, offset: 15519},
+										val:        "to",
+										ignoreCase: false,
+										want:       "\"to\"",
 									},
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 577, col: 34, offset: 15525},
+							label: "qty",
+							expr: &ruleRefExpr{
+								pos:  position{line: 577, col: 38, offset: 15529},
+								name: "QUANTITY",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 577, col: 47, offset: 15538},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 577, col: 49, offset: 15540},
+							label: "name",
+							expr: &ruleRefExpr{
+								pos:  position{line: 577, col: 54, offset: 15545},
+								name: "ITEMNAME",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 577, col: 63, offset: 15554},
+							name: "_",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 577, col: 65, offset: 15556},
+							expr: &litMatcher{
+								pos:        position{line: 577, col: 65, offset: 15556},
+								val:        ",",
+								ignoreCase: false,
+								want:       "\",\"",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 577, col: 70, offset: 15561},
+							name: "_",
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "Settlements",
-			pos:  position{line: 424, col: 1, offset: 10291},
+			pos:  position{line: 585, col: 1, offset: 15850},
 			expr: &actionExpr{
-				pos: position{line: 424, col: 16, offset: 10306},
+				pos: position{line: 585, col: 16, offset: 15865},
 				run: (*parser).callonSettlements1,
 				expr: &seqExpr{
-					pos: position{line: 424, col: 16, offset: 10306},
+					pos: position{line: 585, col: 16, offset: 15865},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 424, col: 16, offset: 10306},
+							pos:        position{line: 585, col: 16, offset: 15865},
 							val:        "Settlements",
 							ignoreCase: false,
 							want:       "\"Settlements\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 424, col: 30, offset: 10320},
-							expr: &seqExpr{
-								pos: position{line: 424, col: 31, offset: 10321},
-								exprs: []any{
-									&notExpr{
-										pos: position{line: 424, col: 31, offset: 10321},
-										expr: &ruleRefExpr{
-											pos:  position{line: 424, col: 32, offset: 10322},
-											name: "FF",
-										},
-									},
-									&anyMatcher{
-										line: 424, col: 35, offset: 10325,
-									},
+						&ruleRefExpr{
+							pos:  position{line: 585, col: 30, offset: 15879},
+							name: "_",
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 585, col: 32, offset: 15881},
+							expr: &ruleRefExpr{
+								pos:  position{line: 585, col: 32, offset: 15881},
+								name: "settlementHeading",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 585, col: 51, offset: 15900},
+							name: "_",
+						},
+						&labeledExpr{
+							pos:   position{line: 585, col: 53, offset: 15902},
+							label: "settlementsi",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 585, col: 66, offset: 15915},
+								expr: &ruleRefExpr{
+									pos:  position{line: 585, col: 66, offset: 15915},
+									name: "settlementLine",
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 585, col: 82, offset: 15931},
+							label: "bleet",
+							expr: &ruleRefExpr{
+								pos:  position{line: 585, col: 88, offset: 15937},
+								name: "untilFF",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "settlementHeading",
+			pos:  position{line: 596, col: 1, offset: 16199},
+			expr: &seqExpr{
+				pos: position{line: 596, col: 22, offset: 16220},
+				exprs: []any{
+					&litMatcher{
+						pos:        position{line: 596, col: 22, offset: 16220},
+						val:        "Hex Code",
+						ignoreCase: false,
+						want:       "\"Hex Code\"",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 596, col: 33, offset: 16231},
+						name: "eatToEOL",
+					},
+				},
+			},
+		},
+		{
+			name: "settlementLine",
+			pos:  position{line: 598, col: 1, offset: 16241},
+			expr: &actionExpr{
+				pos: position{line: 598, col: 19, offset: 16259},
+				run: (*parser).callonsettlementLine1,
+				expr: &seqExpr{
+					pos: position{line: 598, col: 19, offset: 16259},
+					exprs: []any{
+						&labeledExpr{
+							pos:   position{line: 598, col: 19, offset: 16259},
+							label: "hex",
+							expr: &ruleRefExpr{
+								pos:  position{line: 598, col: 23, offset: 16263},
+								name: "HEXID",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 598, col: 29, offset: 16269},
+							label: "columns",
+							expr: &ruleRefExpr{
+								pos:  position{line: 598, col: 37, offset: 16277},
+								name: "eatToEOL",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 598, col: 46, offset: 16286},
+							name: "_",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "untilFF",
+			pos:  position{line: 615, col: 1, offset: 16694},
+			expr: &actionExpr{
+				pos: position{line: 615, col: 12, offset: 16705},
+				run: (*parser).callonuntilFF1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 615, col: 12, offset: 16705},
+					expr: &seqExpr{
+						pos: position{line: 615, col: 13, offset: 16706},
+						exprs: []any{
+							&notExpr{
+								pos: position{line: 615, col: 13, offset: 16706},
+								expr: &ruleRefExpr{
+									pos:  position{line: 615, col: 14, offset: 16707},
+									name: "FF",
+								},
+							},
+							&anyMatcher{
+								line: 615, col: 17, offset: 16710,
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "BACKSLASH",
-			pos:  position{line: 432, col: 1, offset: 10418},
+			pos:  position{line: 621, col: 1, offset: 16764},
 			expr: &litMatcher{
-				pos:        position{line: 432, col: 13, offset: 10430},
+				pos:        position{line: 621, col: 13, offset: 16776},
 				val:        "\\",
 				ignoreCase: false,
 				want:       "\"\\\\\"",
@@ -2180,9 +3212,9 @@ var g = &grammar{
 		},
 		{
 			name: "DIGIT",
-			pos:  position{line: 433, col: 1, offset: 10435},
+			pos:  position{line: 622, col: 1, offset: 16781},
 			expr: &charClassMatcher{
-				pos:        position{line: 433, col: 9, offset: 10443},
+				pos:        position{line: 622, col: 9, offset: 16789},
 				val:        "[0-9]",
 				ranges:     []rune{'0', '9'},
 				ignoreCase: false,
@@ -2191,19 +3223,19 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 434, col: 1, offset: 10449},
+			pos:  position{line: 623, col: 1, offset: 16795},
 			expr: &notExpr{
-				pos: position{line: 434, col: 7, offset: 10455},
+				pos: position{line: 623, col: 7, offset: 16801},
 				expr: &anyMatcher{
-					line: 434, col: 8, offset: 10456,
+					line: 623, col: 8, offset: 16802,
 				},
 			},
 		},
 		{
 			name: "FF",
-			pos:  position{line: 435, col: 1, offset: 10458},
+			pos:  position{line: 624, col: 1, offset: 16804},
 			expr: &litMatcher{
-				pos:        position{line: 435, col: 6, offset: 10463},
+				pos:        position{line: 624, col: 6, offset: 16809},
 				val:        "\f",
 				ignoreCase: false,
 				want:       "\"\\f\"",
@@ -2211,9 +3243,9 @@ var g = &grammar{
 		},
 		{
 			name: "NL",
-			pos:  position{line: 436, col: 1, offset: 10468},
+			pos:  position{line: 625, col: 1, offset: 16814},
 			expr: &litMatcher{
-				pos:        position{line: 436, col: 6, offset: 10473},
+				pos:        position{line: 625, col: 6, offset: 16819},
 				val:        "\n",
 				ignoreCase: false,
 				want:       "\"\\n\"",
@@ -2221,9 +3253,9 @@ var g = &grammar{
 		},
 		{
 			name: "SPACE",
-			pos:  position{line: 437, col: 1, offset: 10478},
+			pos:  position{line: 626, col: 1, offset: 16824},
 			expr: &charClassMatcher{
-				pos:        position{line: 437, col: 9, offset: 10486},
+				pos:        position{line: 626, col: 9, offset: 16832},
 				val:        "[ \\t]",
 				chars:      []rune{' ', '\t'},
 				ignoreCase: false,
@@ -2232,18 +3264,18 @@ var g = &grammar{
 		},
 		{
 			name: "STARTACTIVITIES",
-			pos:  position{line: 438, col: 1, offset: 10492},
+			pos:  position{line: 627, col: 1, offset: 16838},
 			expr: &choiceExpr{
-				pos: position{line: 438, col: 19, offset: 10510},
+				pos: position{line: 627, col: 19, offset: 16856},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 438, col: 19, offset: 10510},
+						pos:        position{line: 627, col: 19, offset: 16856},
 						val:        "Tribe Activities:",
 						ignoreCase: false,
 						want:       "\"Tribe Activities:\"",
 					},
 					&litMatcher{
-						pos:        position{line: 438, col: 41, offset: 10532},
+						pos:        position{line: 627, col: 41, offset: 16878},
 						val:        "Final Activities",
 						ignoreCase: false,
 						want:       "\"Final Activities\"",
@@ -2253,9 +3285,9 @@ var g = &grammar{
 		},
 		{
 			name: "UPPER",
-			pos:  position{line: 439, col: 1, offset: 10551},
+			pos:  position{line: 628, col: 1, offset: 16897},
 			expr: &charClassMatcher{
-				pos:        position{line: 439, col: 9, offset: 10559},
+				pos:        position{line: 628, col: 9, offset: 16905},
 				val:        "[A-Z]",
 				ranges:     []rune{'A', 'Z'},
 				ignoreCase: false,
@@ -2264,24 +3296,24 @@ var g = &grammar{
 		},
 		{
 			name: "eatToEOL",
-			pos:  position{line: 441, col: 1, offset: 10566},
+			pos:  position{line: 630, col: 1, offset: 16912},
 			expr: &actionExpr{
-				pos: position{line: 441, col: 13, offset: 10578},
+				pos: position{line: 630, col: 13, offset: 16924},
 				run: (*parser).calloneatToEOL1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 441, col: 13, offset: 10578},
+					pos: position{line: 630, col: 13, offset: 16924},
 					expr: &seqExpr{
-						pos: position{line: 441, col: 14, offset: 10579},
+						pos: position{line: 630, col: 14, offset: 16925},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 441, col: 14, offset: 10579},
+								pos: position{line: 630, col: 14, offset: 16925},
 								expr: &ruleRefExpr{
-									pos:  position{line: 441, col: 15, offset: 10580},
+									pos:  position{line: 630, col: 15, offset: 16926},
 									name: "NL",
 								},
 							},
 							&anyMatcher{
-								line: 441, col: 18, offset: 10583,
+								line: 630, col: 18, offset: 16929,
 							},
 						},
 					},
@@ -2290,26 +3322,26 @@ var g = &grammar{
 		},
 		{
 			name: "eatToSentinel",
-			pos:  position{line: 445, col: 1, offset: 10623},
+			pos:  position{line: 634, col: 1, offset: 16969},
 			expr: &actionExpr{
-				pos: position{line: 445, col: 18, offset: 10640},
+				pos: position{line: 634, col: 18, offset: 16986},
 				run: (*parser).calloneatToSentinel1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 445, col: 18, offset: 10640},
+					pos: position{line: 634, col: 18, offset: 16986},
 					expr: &seqExpr{
-						pos: position{line: 445, col: 19, offset: 10641},
+						pos: position{line: 634, col: 19, offset: 16987},
 						exprs: []any{
 							&notExpr{
-								pos: position{line: 445, col: 19, offset: 10641},
+								pos: position{line: 634, col: 19, offset: 16987},
 								expr: &litMatcher{
-									pos:        position{line: 445, col: 20, offset: 10642},
+									pos:        position{line: 634, col: 20, offset: 16988},
 									val:        "$$$",
 									ignoreCase: false,
 									want:       "\"$$$\"",
 								},
 							},
 							&anyMatcher{
-								line: 445, col: 26, offset: 10648,
+								line: 634, col: 26, offset: 16994,
 							},
 						},
 					},
@@ -2318,35 +3350,35 @@ var g = &grammar{
 		},
 		{
 			name: "BLEET",
-			pos:  position{line: 449, col: 1, offset: 10688},
+			pos:  position{line: 638, col: 1, offset: 17034},
 			expr: &actionExpr{
-				pos: position{line: 449, col: 10, offset: 10697},
+				pos: position{line: 638, col: 10, offset: 17043},
 				run: (*parser).callonBLEET1,
 				expr: &seqExpr{
-					pos: position{line: 449, col: 10, offset: 10697},
+					pos: position{line: 638, col: 10, offset: 17043},
 					exprs: []any{
 						&zeroOrMoreExpr{
-							pos: position{line: 449, col: 10, offset: 10697},
+							pos: position{line: 638, col: 10, offset: 17043},
 							expr: &seqExpr{
-								pos: position{line: 449, col: 11, offset: 10698},
+								pos: position{line: 638, col: 11, offset: 17044},
 								exprs: []any{
 									&notExpr{
-										pos: position{line: 449, col: 11, offset: 10698},
+										pos: position{line: 638, col: 11, offset: 17044},
 										expr: &ruleRefExpr{
-											pos:  position{line: 449, col: 12, offset: 10699},
+											pos:  position{line: 638, col: 12, offset: 17045},
 											name: "FF",
 										},
 									},
 									&anyMatcher{
-										line: 449, col: 15, offset: 10702,
+										line: 638, col: 15, offset: 17048,
 									},
 								},
 							},
 						},
 						&andExpr{
-							pos: position{line: 449, col: 19, offset: 10706},
+							pos: position{line: 638, col: 19, offset: 17052},
 							expr: &ruleRefExpr{
-								pos:  position{line: 449, col: 20, offset: 10707},
+								pos:  position{line: 638, col: 20, offset: 17053},
 								name: "FF",
 							},
 						},
@@ -2356,25 +3388,25 @@ var g = &grammar{
 		},
 		{
 			name: "COMMODITY",
-			pos:  position{line: 454, col: 1, offset: 10765},
+			pos:  position{line: 643, col: 1, offset: 17111},
 			expr: &choiceExpr{
-				pos: position{line: 454, col: 14, offset: 10778},
+				pos: position{line: 643, col: 14, offset: 17124},
 				alternatives: []any{
 					&actionExpr{
-						pos: position{line: 454, col: 14, offset: 10778},
+						pos: position{line: 643, col: 14, offset: 17124},
 						run: (*parser).callonCOMMODITY2,
 						expr: &litMatcher{
-							pos:        position{line: 454, col: 14, offset: 10778},
+							pos:        position{line: 643, col: 14, offset: 17124},
 							val:        "coffee",
 							ignoreCase: true,
 							want:       "\"coffee\"i",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 456, col: 5, offset: 10825},
+						pos: position{line: 645, col: 5, offset: 17171},
 						run: (*parser).callonCOMMODITY4,
 						expr: &litMatcher{
-							pos:        position{line: 456, col: 5, offset: 10825},
+							pos:        position{line: 645, col: 5, offset: 17171},
 							val:        "frankincense",
 							ignoreCase: true,
 							want:       "\"frankincense\"i",
@@ -2385,37 +3417,37 @@ var g = &grammar{
 		},
 		{
 			name: "COURIERID",
-			pos:  position{line: 460, col: 1, offset: 10877},
+			pos:  position{line: 649, col: 1, offset: 17223},
 			expr: &actionExpr{
-				pos: position{line: 460, col: 14, offset: 10890},
+				pos: position{line: 649, col: 14, offset: 17236},
 				run: (*parser).callonCOURIERID1,
 				expr: &seqExpr{
-					pos: position{line: 460, col: 14, offset: 10890},
+					pos: position{line: 649, col: 14, offset: 17236},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 460, col: 14, offset: 10890},
+							pos:  position{line: 649, col: 14, offset: 17236},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 460, col: 20, offset: 10896},
+							pos:  position{line: 649, col: 20, offset: 17242},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 460, col: 26, offset: 10902},
+							pos:  position{line: 649, col: 26, offset: 17248},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 460, col: 32, offset: 10908},
+							pos:  position{line: 649, col: 32, offset: 17254},
 							name: "DIGIT",
 						},
 						&litMatcher{
-							pos:        position{line: 460, col: 38, offset: 10914},
+							pos:        position{line: 649, col: 38, offset: 17260},
 							val:        "c",
 							ignoreCase: false,
 							want:       "\"c\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 460, col: 42, offset: 10918},
+							pos:  position{line: 649, col: 42, offset: 17264},
 							name: "DIGIT",
 						},
 					},
@@ -2424,61 +3456,61 @@ var g = &grammar{
 		},
 		{
 			name: "DDMMYYYY",
-			pos:  position{line: 464, col: 1, offset: 10960},
+			pos:  position{line: 653, col: 1, offset: 17306},
 			expr: &actionExpr{
-				pos: position{line: 464, col: 13, offset: 10972},
+				pos: position{line: 653, col: 13, offset: 17318},
 				run: (*parser).callonDDMMYYYY1,
 				expr: &seqExpr{
-					pos: position{line: 464, col: 13, offset: 10972},
+					pos: position{line: 653, col: 13, offset: 17318},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 464, col: 13, offset: 10972},
+							pos:  position{line: 653, col: 13, offset: 17318},
 							name: "DIGIT",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 464, col: 19, offset: 10978},
+							pos: position{line: 653, col: 19, offset: 17324},
 							expr: &ruleRefExpr{
-								pos:  position{line: 464, col: 19, offset: 10978},
+								pos:  position{line: 653, col: 19, offset: 17324},
 								name: "DIGIT",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 464, col: 26, offset: 10985},
+							pos:        position{line: 653, col: 26, offset: 17331},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 464, col: 30, offset: 10989},
+							pos:  position{line: 653, col: 30, offset: 17335},
 							name: "DIGIT",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 464, col: 36, offset: 10995},
+							pos: position{line: 653, col: 36, offset: 17341},
 							expr: &ruleRefExpr{
-								pos:  position{line: 464, col: 36, offset: 10995},
+								pos:  position{line: 653, col: 36, offset: 17341},
 								name: "DIGIT",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 464, col: 43, offset: 11002},
+							pos:        position{line: 653, col: 43, offset: 17348},
 							val:        "/",
 							ignoreCase: false,
 							want:       "\"/\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 464, col: 47, offset: 11006},
+							pos:  position{line: 653, col: 47, offset: 17352},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 464, col: 53, offset: 11012},
+							pos:  position{line: 653, col: 53, offset: 17358},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 464, col: 59, offset: 11018},
+							pos:  position{line: 653, col: 59, offset: 17364},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 464, col: 65, offset: 11024},
+							pos:  position{line: 653, col: 65, offset: 17370},
 							name: "DIGIT",
 						},
 					},
@@ -2487,45 +3519,45 @@ var g = &grammar{
 		},
 		{
 			name: "DIRECTION",
-			pos:  position{line: 468, col: 1, offset: 11066},
+			pos:  position{line: 657, col: 1, offset: 17412},
 			expr: &actionExpr{
-				pos: position{line: 468, col: 14, offset: 11079},
+				pos: position{line: 657, col: 14, offset: 17425},
 				run: (*parser).callonDIRECTION1,
 				expr: &choiceExpr{
-					pos: position{line: 468, col: 15, offset: 11080},
+					pos: position{line: 657, col: 15, offset: 17426},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 468, col: 15, offset: 11080},
+							pos:        position{line: 657, col: 15, offset: 17426},
 							val:        "NE",
 							ignoreCase: false,
 							want:       "\"NE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 22, offset: 11087},
+							pos:        position{line: 657, col: 22, offset: 17433},
 							val:        "NW",
 							ignoreCase: false,
 							want:       "\"NW\"",
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 29, offset: 11094},
+							pos:        position{line: 657, col: 29, offset: 17440},
 							val:        "N",
 							ignoreCase: false,
 							want:       "\"N\"",
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 35, offset: 11100},
+							pos:        position{line: 657, col: 35, offset: 17446},
 							val:        "SE",
 							ignoreCase: false,
 							want:       "\"SE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 42, offset: 11107},
+							pos:        position{line: 657, col: 42, offset: 17453},
 							val:        "SW",
 							ignoreCase: false,
 							want:       "\"SW\"",
 						},
 						&litMatcher{
-							pos:        position{line: 468, col: 49, offset: 11114},
+							pos:        position{line: 657, col: 49, offset: 17460},
 							val:        "S",
 							ignoreCase: false,
 							want:       "\"S\"",
@@ -2536,38 +3568,108 @@ var g = &grammar{
 		},
 		{
 			name: "ELEMENTID",
-			pos:  position{line: 472, col: 1, offset: 11155},
+			pos:  position{line: 661, col: 1, offset: 17501},
 			expr: &actionExpr{
-				pos: position{line: 472, col: 14, offset: 11168},
+				pos: position{line: 661, col: 14, offset: 17514},
 				run: (*parser).callonELEMENTID1,
 				expr: &seqExpr{
-					pos: position{line: 472, col: 14, offset: 11168},
+					pos: position{line: 661, col: 14, offset: 17514},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 472, col: 14, offset: 11168},
+							pos:  position{line: 661, col: 14, offset: 17514},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 472, col: 20, offset: 11174},
+							pos:  position{line: 661, col: 20, offset: 17520},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 472, col: 26, offset: 11180},
+							pos:  position{line: 661, col: 26, offset: 17526},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 472, col: 32, offset: 11186},
+							pos:  position{line: 661, col: 32, offset: 17532},
 							name: "DIGIT",
 						},
 						&litMatcher{
-							pos:        position{line: 472, col: 38, offset: 11192},
+							pos:        position{line: 661, col: 38, offset: 17538},
 							val:        "e",
 							ignoreCase: false,
 							want:       "\"e\"",
 						},
-						&ruleRefExpr{
-							pos:  position{line: 472, col: 42, offset: 11196},
-							name: "DIGIT",
+						&ruleRefExpr{
+							pos:  position{line: 661, col: 42, offset: 17542},
+							name: "DIGIT",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ITEMNAME",
+			pos:  position{line: 666, col: 1, offset: 17635},
+			expr: &actionExpr{
+				pos: position{line: 666, col: 13, offset: 17647},
+				run: (*parser).callonITEMNAME1,
+				expr: &seqExpr{
+					pos: position{line: 666, col: 13, offset: 17647},
+					exprs: []any{
+						&charClassMatcher{
+							pos:        position{line: 666, col: 13, offset: 17647},
+							val:        "[A-Za-z]",
+							ranges:     []rune{'A', 'Z', 'a', 'z'},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 666, col: 22, offset: 17656},
+							expr: &charClassMatcher{
+								pos:        position{line: 666, col: 22, offset: 17656},
+								val:        "[A-Za-z'-]",
+								chars:      []rune{'\'', '-'},
+								ranges:     []rune{'A', 'Z', 'a', 'z'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 666, col: 34, offset: 17668},
+							expr: &seqExpr{
+								pos: position{line: 666, col: 35, offset: 17669},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 666, col: 35, offset: 17669},
+										val:        " ",
+										ignoreCase: false,
+										want:       "\" \"",
+									},
+									&notExpr{
+										pos: position{line: 666, col: 39, offset: 17673},
+										expr: &ruleRefExpr{
+											pos:  position{line: 666, col: 40, offset: 17674},
+											name: "possessionHeading",
+										},
+									},
+									&charClassMatcher{
+										pos:        position{line: 666, col: 58, offset: 17692},
+										val:        "[A-Za-z]",
+										ranges:     []rune{'A', 'Z', 'a', 'z'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 666, col: 67, offset: 17701},
+										expr: &charClassMatcher{
+											pos:        position{line: 666, col: 67, offset: 17701},
+											val:        "[A-Za-z'-]",
+											chars:      []rune{'\'', '-'},
+											ranges:     []rune{'A', 'Z', 'a', 'z'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+							},
 						},
 					},
 				},
@@ -2575,41 +3677,57 @@ var g = &grammar{
 		},
 		{
 			name: "HEXID",
-			pos:  position{line: 476, col: 1, offset: 11238},
+			pos:  position{line: 671, col: 1, offset: 17828},
 			expr: &actionExpr{
-				pos: position{line: 476, col: 10, offset: 11247},
+				pos: position{line: 671, col: 10, offset: 17837},
 				run: (*parser).callonHEXID1,
 				expr: &seqExpr{
-					pos: position{line: 476, col: 10, offset: 11247},
+					pos: position{line: 671, col: 10, offset: 17837},
 					exprs: []any{
-						&ruleRefExpr{
-							pos:  position{line: 476, col: 10, offset: 11247},
-							name: "UPPER",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 476, col: 16, offset: 11253},
-							name: "UPPER",
+						&choiceExpr{
+							pos: position{line: 671, col: 11, offset: 17838},
+							alternatives: []any{
+								&seqExpr{
+									pos: position{line: 671, col: 11, offset: 17838},
+									exprs: []any{
+										&ruleRefExpr{
+											pos:  position{line: 671, col: 11, offset: 17838},
+											name: "UPPER",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 671, col: 17, offset: 17844},
+											name: "UPPER",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 671, col: 25, offset: 17852},
+									val:        "##",
+									ignoreCase: false,
+									want:       "\"##\"",
+								},
+							},
 						},
 						&litMatcher{
-							pos:        position{line: 476, col: 22, offset: 11259},
+							pos:        position{line: 671, col: 31, offset: 17858},
 							val:        " ",
 							ignoreCase: false,
 							want:       "\" \"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 476, col: 26, offset: 11263},
+							pos:  position{line: 671, col: 35, offset: 17862},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 476, col: 32, offset: 11269},
+							pos:  position{line: 671, col: 41, offset: 17868},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 476, col: 38, offset: 11275},
+							pos:  position{line: 671, col: 47, offset: 17874},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 476, col: 44, offset: 11281},
+							pos:  position{line: 671, col: 53, offset: 17880},
 							name: "DIGIT",
 						},
 					},
@@ -2618,27 +3736,27 @@ var g = &grammar{
 		},
 		{
 			name: "MONTHID",
-			pos:  position{line: 480, col: 1, offset: 11323},
+			pos:  position{line: 675, col: 1, offset: 17922},
 			expr: &actionExpr{
-				pos: position{line: 480, col: 12, offset: 11334},
+				pos: position{line: 675, col: 12, offset: 17933},
 				run: (*parser).callonMONTHID1,
 				expr: &seqExpr{
-					pos: position{line: 480, col: 12, offset: 11334},
+					pos: position{line: 675, col: 12, offset: 17933},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 480, col: 12, offset: 11334},
+							pos:        position{line: 675, col: 12, offset: 17933},
 							val:        "#",
 							ignoreCase: false,
 							want:       "\"#\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 480, col: 16, offset: 11338},
+							pos:  position{line: 675, col: 16, offset: 17937},
 							name: "DIGIT",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 480, col: 22, offset: 11344},
+							pos: position{line: 675, col: 22, offset: 17943},
 							expr: &ruleRefExpr{
-								pos:  position{line: 480, col: 22, offset: 11344},
+								pos:  position{line: 675, col: 22, offset: 17943},
 								name: "DIGIT",
 							},
 						},
@@ -2648,35 +3766,35 @@ var g = &grammar{
 		},
 		{
 			name: "NUMBER",
-			pos:  position{line: 484, col: 1, offset: 11391},
+			pos:  position{line: 679, col: 1, offset: 17990},
 			expr: &actionExpr{
-				pos: position{line: 484, col: 11, offset: 11401},
+				pos: position{line: 679, col: 11, offset: 18000},
 				run: (*parser).callonNUMBER1,
 				expr: &seqExpr{
-					pos: position{line: 484, col: 11, offset: 11401},
+					pos: position{line: 679, col: 11, offset: 18000},
 					exprs: []any{
 						&oneOrMoreExpr{
-							pos: position{line: 484, col: 11, offset: 11401},
+							pos: position{line: 679, col: 11, offset: 18000},
 							expr: &ruleRefExpr{
-								pos:  position{line: 484, col: 11, offset: 11401},
+								pos:  position{line: 679, col: 11, offset: 18000},
 								name: "DIGIT",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 484, col: 18, offset: 11408},
+							pos: position{line: 679, col: 18, offset: 18007},
 							expr: &seqExpr{
-								pos: position{line: 484, col: 19, offset: 11409},
+								pos: position{line: 679, col: 19, offset: 18008},
 								exprs: []any{
 									&litMatcher{
-										pos:        position{line: 484, col: 19, offset: 11409},
+										pos:        position{line: 679, col: 19, offset: 18008},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
 									},
 									&oneOrMoreExpr{
-										pos: position{line: 484, col: 23, offset: 11413},
+										pos: position{line: 679, col: 23, offset: 18012},
 										expr: &ruleRefExpr{
-											pos:  position{line: 484, col: 23, offset: 11413},
+											pos:  position{line: 679, col: 23, offset: 18012},
 											name: "DIGIT",
 										},
 									},
@@ -2689,42 +3807,88 @@ var g = &grammar{
 		},
 		{
 			name: "OPTMOVEINFO",
-			pos:  position{line: 488, col: 1, offset: 11458},
+			pos:  position{line: 683, col: 1, offset: 18057},
 			expr: &actionExpr{
-				pos: position{line: 488, col: 16, offset: 11473},
+				pos: position{line: 683, col: 16, offset: 18072},
 				run: (*parser).callonOPTMOVEINFO1,
 				expr: &seqExpr{
-					pos: position{line: 488, col: 16, offset: 11473},
+					pos: position{line: 683, col: 16, offset: 18072},
 					exprs: []any{
 						&litMatcher{
-							pos:        position{line: 488, col: 16, offset: 11473},
+							pos:        position{line: 683, col: 16, offset: 18072},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 488, col: 20, offset: 11477},
+							pos: position{line: 683, col: 20, offset: 18076},
 							expr: &seqExpr{
-								pos: position{line: 488, col: 21, offset: 11478},
+								pos: position{line: 683, col: 21, offset: 18077},
 								exprs: []any{
 									&notExpr{
-										pos: position{line: 488, col: 21, offset: 11478},
+										pos: position{line: 683, col: 21, offset: 18077},
 										expr: &choiceExpr{
-											pos: position{line: 488, col: 23, offset: 11480},
+											pos: position{line: 683, col: 23, offset: 18079},
 											alternatives: []any{
 												&ruleRefExpr{
-													pos:  position{line: 488, col: 23, offset: 11480},
+													pos:  position{line: 683, col: 23, offset: 18079},
 													name: "BACKSLASH",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 488, col: 35, offset: 11492},
+													pos:  position{line: 683, col: 35, offset: 18091},
 													name: "NL",
 												},
 											},
 										},
 									},
 									&anyMatcher{
-										line: 488, col: 39, offset: 11496,
+										line: 683, col: 39, offset: 18095,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "QUANTITY",
+			pos:  position{line: 688, col: 1, offset: 18234},
+			expr: &actionExpr{
+				pos: position{line: 688, col: 13, offset: 18246},
+				run: (*parser).callonQUANTITY1,
+				expr: &seqExpr{
+					pos: position{line: 688, col: 13, offset: 18246},
+					exprs: []any{
+						&oneOrMoreExpr{
+							pos: position{line: 688, col: 13, offset: 18246},
+							expr: &ruleRefExpr{
+								pos:  position{line: 688, col: 13, offset: 18246},
+								name: "DIGIT",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 688, col: 20, offset: 18253},
+							expr: &seqExpr{
+								pos: position{line: 688, col: 21, offset: 18254},
+								exprs: []any{
+									&litMatcher{
+										pos:        position{line: 688, col: 21, offset: 18254},
+										val:        ",",
+										ignoreCase: false,
+										want:       "\",\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 688, col: 25, offset: 18258},
+										name: "DIGIT",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 688, col: 31, offset: 18264},
+										name: "DIGIT",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 688, col: 37, offset: 18270},
+										name: "DIGIT",
 									},
 								},
 							},
@@ -2735,41 +3899,41 @@ var g = &grammar{
 		},
 		{
 			name: "REST",
-			pos:  position{line: 492, col: 1, offset: 11555},
+			pos:  position{line: 692, col: 1, offset: 18314},
 			expr: &actionExpr{
-				pos: position{line: 492, col: 9, offset: 11563},
+				pos: position{line: 692, col: 9, offset: 18322},
 				run: (*parser).callonREST1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 492, col: 9, offset: 11563},
+					pos: position{line: 692, col: 9, offset: 18322},
 					expr: &anyMatcher{
-						line: 492, col: 9, offset: 11563,
+						line: 692, col: 9, offset: 18322,
 					},
 				},
 			},
 		},
 		{
 			name: "SEASON",
-			pos:  position{line: 497, col: 1, offset: 11619},
+			pos:  position{line: 697, col: 1, offset: 18378},
 			expr: &choiceExpr{
-				pos: position{line: 497, col: 11, offset: 11629},
+				pos: position{line: 697, col: 11, offset: 18388},
 				alternatives: []any{
 					&litMatcher{
-						pos:        position{line: 497, col: 11, offset: 11629},
+						pos:        position{line: 697, col: 11, offset: 18388},
 						val:        "Spring",
 						ignoreCase: false,
 						want:       "\"Spring\"",
 					},
 					&litMatcher{
-						pos:        position{line: 497, col: 22, offset: 11640},
+						pos:        position{line: 697, col: 22, offset: 18399},
 						val:        "Summer",
 						ignoreCase: false,
 						want:       "\"Summer\"",
 					},
 					&actionExpr{
-						pos: position{line: 497, col: 33, offset: 11651},
+						pos: position{line: 697, col: 33, offset: 18410},
 						run: (*parser).callonSEASON4,
 						expr: &litMatcher{
-							pos:        position{line: 497, col: 33, offset: 11651},
+							pos:        position{line: 697, col: 33, offset: 18410},
 							val:        "Winter",
 							ignoreCase: false,
 							want:       "\"Winter\"",
@@ -2780,93 +3944,93 @@ var g = &grammar{
 		},
 		{
 			name: "TERRAIN",
-			pos:  position{line: 501, col: 1, offset: 11696},
+			pos:  position{line: 701, col: 1, offset: 18455},
 			expr: &actionExpr{
-				pos: position{line: 501, col: 15, offset: 11710},
+				pos: position{line: 701, col: 15, offset: 18469},
 				run: (*parser).callonTERRAIN1,
 				expr: &choiceExpr{
-					pos: position{line: 502, col: 5, offset: 11716},
+					pos: position{line: 702, col: 5, offset: 18475},
 					alternatives: []any{
 						&litMatcher{
-							pos:        position{line: 502, col: 5, offset: 11716},
+							pos:        position{line: 702, col: 5, offset: 18475},
 							val:        "CONIFER HILLS",
 							ignoreCase: false,
 							want:       "\"CONIFER HILLS\"",
 						},
 						&litMatcher{
-							pos:        position{line: 503, col: 5, offset: 11738},
+							pos:        position{line: 703, col: 5, offset: 18497},
 							val:        "GRASSY HILLS",
 							ignoreCase: false,
 							want:       "\"GRASSY HILLS\"",
 						},
 						&litMatcher{
-							pos:        position{line: 504, col: 5, offset: 11760},
+							pos:        position{line: 704, col: 5, offset: 18519},
 							val:        "OCEAN",
 							ignoreCase: false,
 							want:       "\"OCEAN\"",
 						},
 						&litMatcher{
-							pos:        position{line: 505, col: 5, offset: 11782},
+							pos:        position{line: 705, col: 5, offset: 18541},
 							val:        "PRAIRIE",
 							ignoreCase: false,
 							want:       "\"PRAIRIE\"",
 						},
 						&litMatcher{
-							pos:        position{line: 506, col: 5, offset: 11804},
+							pos:        position{line: 706, col: 5, offset: 18563},
 							val:        "ROCKY HILLS",
 							ignoreCase: false,
 							want:       "\"ROCKY HILLS\"",
 						},
 						&litMatcher{
-							pos:        position{line: 507, col: 5, offset: 11826},
+							pos:        position{line: 707, col: 5, offset: 18585},
 							val:        "RIVER",
 							ignoreCase: false,
 							want:       "\"RIVER\"",
 						},
 						&litMatcher{
-							pos:        position{line: 508, col: 5, offset: 11848},
+							pos:        position{line: 708, col: 5, offset: 18607},
 							val:        "SWAMP",
 							ignoreCase: false,
 							want:       "\"SWAMP\"",
 						},
 						&litMatcher{
-							pos:        position{line: 509, col: 5, offset: 11870},
+							pos:        position{line: 709, col: 5, offset: 18629},
 							val:        "CH",
 							ignoreCase: false,
 							want:       "\"CH\"",
 						},
 						&litMatcher{
-							pos:        position{line: 509, col: 12, offset: 11877},
+							pos:        position{line: 709, col: 12, offset: 18636},
 							val:        "GH",
 							ignoreCase: false,
 							want:       "\"GH\"",
 						},
 						&litMatcher{
-							pos:        position{line: 509, col: 19, offset: 11884},
+							pos:        position{line: 709, col: 19, offset: 18643},
 							val:        "O",
 							ignoreCase: false,
 							want:       "\"O\"",
 						},
 						&litMatcher{
-							pos:        position{line: 509, col: 25, offset: 11890},
+							pos:        position{line: 709, col: 25, offset: 18649},
 							val:        "PR",
 							ignoreCase: false,
 							want:       "\"PR\"",
 						},
 						&litMatcher{
-							pos:        position{line: 509, col: 32, offset: 11897},
+							pos:        position{line: 709, col: 32, offset: 18656},
 							val:        "RH",
 							ignoreCase: false,
 							want:       "\"RH\"",
 						},
 						&litMatcher{
-							pos:        position{line: 509, col: 39, offset: 11904},
+							pos:        position{line: 709, col: 39, offset: 18663},
 							val:        "R",
 							ignoreCase: false,
 							want:       "\"R\"",
 						},
 						&litMatcher{
-							pos:        position{line: 509, col: 45, offset: 11910},
+							pos:        position{line: 709, col: 45, offset: 18669},
 							val:        "SW",
 							ignoreCase: false,
 							want:       "\"SW\"",
@@ -2875,29 +4039,56 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "SKILLCODE",
+			pos:  position{line: 732, col: 1, offset: 19171},
+			expr: &actionExpr{
+				pos: position{line: 732, col: 14, offset: 19184},
+				run: (*parser).callonSKILLCODE1,
+				expr: &seqExpr{
+					pos: position{line: 732, col: 14, offset: 19184},
+					exprs: []any{
+						&ruleRefExpr{
+							pos:  position{line: 732, col: 14, offset: 19184},
+							name: "UPPER",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 732, col: 20, offset: 19190},
+							expr: &charClassMatcher{
+								pos:        position{line: 732, col: 20, offset: 19190},
+								val:        "[A-Za-z]",
+								ranges:     []rune{'A', 'Z', 'a', 'z'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "TRIBEID",
-			pos:  position{line: 532, col: 1, offset: 12412},
+			pos:  position{line: 736, col: 1, offset: 19236},
 			expr: &actionExpr{
-				pos: position{line: 532, col: 12, offset: 12423},
+				pos: position{line: 736, col: 12, offset: 19247},
 				run: (*parser).callonTRIBEID1,
 				expr: &seqExpr{
-					pos: position{line: 532, col: 12, offset: 12423},
+					pos: position{line: 736, col: 12, offset: 19247},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 532, col: 12, offset: 12423},
+							pos:  position{line: 736, col: 12, offset: 19247},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 532, col: 18, offset: 12429},
+							pos:  position{line: 736, col: 18, offset: 19253},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 532, col: 24, offset: 12435},
+							pos:  position{line: 736, col: 24, offset: 19259},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 532, col: 30, offset: 12441},
+							pos:  position{line: 736, col: 30, offset: 19265},
 							name: "DIGIT",
 						},
 					},
@@ -2906,37 +4097,37 @@ var g = &grammar{
 		},
 		{
 			name: "TURNID",
-			pos:  position{line: 536, col: 1, offset: 12483},
+			pos:  position{line: 740, col: 1, offset: 19307},
 			expr: &actionExpr{
-				pos: position{line: 536, col: 11, offset: 12493},
+				pos: position{line: 740, col: 11, offset: 19317},
 				run: (*parser).callonTURNID1,
 				expr: &seqExpr{
-					pos: position{line: 536, col: 11, offset: 12493},
+					pos: position{line: 740, col: 11, offset: 19317},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 536, col: 11, offset: 12493},
+							pos:  position{line: 740, col: 11, offset: 19317},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 536, col: 17, offset: 12499},
+							pos:  position{line: 740, col: 17, offset: 19323},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 536, col: 23, offset: 12505},
+							pos:  position{line: 740, col: 23, offset: 19329},
 							name: "DIGIT",
 						},
 						&litMatcher{
-							pos:        position{line: 536, col: 29, offset: 12511},
+							pos:        position{line: 740, col: 29, offset: 19335},
 							val:        "-",
 							ignoreCase: false,
 							want:       "\"-\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 536, col: 33, offset: 12515},
+							pos:  position{line: 740, col: 33, offset: 19339},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 536, col: 39, offset: 12521},
+							pos:  position{line: 740, col: 39, offset: 19345},
 							name: "DIGIT",
 						},
 					},
@@ -2945,43 +4136,43 @@ var g = &grammar{
 		},
 		{
 			name: "UNITID",
-			pos:  position{line: 540, col: 1, offset: 12563},
+			pos:  position{line: 744, col: 1, offset: 19387},
 			expr: &actionExpr{
-				pos: position{line: 540, col: 11, offset: 12573},
+				pos: position{line: 744, col: 11, offset: 19397},
 				run: (*parser).callonUNITID1,
 				expr: &seqExpr{
-					pos: position{line: 540, col: 11, offset: 12573},
+					pos: position{line: 744, col: 11, offset: 19397},
 					exprs: []any{
 						&ruleRefExpr{
-							pos:  position{line: 540, col: 11, offset: 12573},
+							pos:  position{line: 744, col: 11, offset: 19397},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 540, col: 17, offset: 12579},
+							pos:  position{line: 744, col: 17, offset: 19403},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 540, col: 23, offset: 12585},
+							pos:  position{line: 744, col: 23, offset: 19409},
 							name: "DIGIT",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 540, col: 29, offset: 12591},
+							pos:  position{line: 744, col: 29, offset: 19415},
 							name: "DIGIT",
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 540, col: 35, offset: 12597},
+							pos: position{line: 744, col: 35, offset: 19421},
 							expr: &seqExpr{
-								pos: position{line: 540, col: 36, offset: 12598},
+								pos: position{line: 744, col: 36, offset: 19422},
 								exprs: []any{
 									&charClassMatcher{
-										pos:        position{line: 540, col: 36, offset: 12598},
+										pos:        position{line: 744, col: 36, offset: 19422},
 										val:        "[ce]",
 										chars:      []rune{'c', 'e'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&ruleRefExpr{
-										pos:  position{line: 540, col: 41, offset: 12603},
+										pos:  position{line: 744, col: 41, offset: 19427},
 										name: "DIGIT",
 									},
 								},
@@ -2993,12 +4184,12 @@ var g = &grammar{
 		},
 		{
 			name: "WEATHER",
-			pos:  position{line: 544, col: 1, offset: 12647},
+			pos:  position{line: 748, col: 1, offset: 19471},
 			expr: &actionExpr{
-				pos: position{line: 544, col: 12, offset: 12658},
+				pos: position{line: 748, col: 12, offset: 19482},
 				run: (*parser).callonWEATHER1,
 				expr: &litMatcher{
-					pos:        position{line: 544, col: 12, offset: 12658},
+					pos:        position{line: 748, col: 12, offset: 19482},
 					val:        "FINE",
 					ignoreCase: false,
 					want:       "\"FINE\"",
@@ -3007,11 +4198,11 @@ var g = &grammar{
 		},
 		{
 			name: "_",
-			pos:  position{line: 548, col: 1, offset: 12701},
+			pos:  position{line: 752, col: 1, offset: 19525},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 548, col: 5, offset: 12705},
+				pos: position{line: 752, col: 5, offset: 19529},
 				expr: &charClassMatcher{
-					pos:        position{line: 548, col: 5, offset: 12705},
+					pos:        position{line: 752, col: 5, offset: 19529},
 					val:        "[ \\t\\r\\n]",
 					chars:      []rune{' ', '\t', '\r', '\n'},
 					ignoreCase: false,
@@ -3022,7 +4213,7 @@ var g = &grammar{
 	},
 }
 
-func (c *current) onReportFile1(rptsi, rest any) (any, error) {
+func (c *current) onReportFile1(rptsi, transfers, settlements, rest any) (any, error) {
 	rpt := Report{T: make(map[string]*TribeReport)}
 
 	rpts := rptsi.([]any)
@@ -3035,6 +4226,13 @@ func (c *current) onReportFile1(rptsi, rest any) (any, error) {
 		}
 	}
 
+	if transfers != nil {
+		rpt.Transfers = transfers.(*Transfers)
+	}
+	if settlements != nil {
+		rpt.Settlements = settlements.(*Settlements)
+	}
+
 	rpt.Rest = rest.(string)
 
 	return &rpt, nil
@@ -3043,7 +4241,7 @@ func (c *current) onReportFile1(rptsi, rest any) (any, error) {
 func (p *parser) callonReportFile1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onReportFile1(stack["rptsi"], stack["rest"])
+	return p.cur.onReportFile1(stack["rptsi"], stack["transfers"], stack["settlements"], stack["rest"])
 }
 
 func (c *current) onUnitReport1(id, commonHeadingi, goodsTribe, gmNotes, tact, fact, tmove, scouts, status, people, possessions, skills, morale, weight, truces, b any) (any, error) {
@@ -3089,6 +4287,30 @@ func (p *parser) callonUnitReport1() (any, error) {
 	return p.cur.onUnitReport1(stack["id"], stack["commonHeadingi"], stack["goodsTribe"], stack["gmNotes"], stack["tact"], stack["fact"], stack["tmove"], stack["scouts"], stack["status"], stack["people"], stack["possessions"], stack["skills"], stack["morale"], stack["weight"], stack["truces"], stack["b"])
 }
 
+func (c *current) onBadUnitReport1(id any) (any, error) {
+	var t TribeReport
+	t.Id = id.(string)
+	t.Bleet = string(c.text)
+	t.Errors = append(t.Errors, badUnit(t.Id, c.text, c.pos))
+	return &t, nil
+}
+
+func (p *parser) callonBadUnitReport1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBadUnitReport1(stack["id"])
+}
+
+func (c *current) onuntilNextUnit1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilNextUnit1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilNextUnit1()
+}
+
 func (c *current) onCommonHeading1(currentHex, startingHex, turn any) (any, error) {
 	var o CommonHeading
 	o.CurrentHex = currentHex.(string)
@@ -3304,7 +4526,7 @@ func (c *current) onblockedMove1(info any) (any, error) {
 	if debug {
 		log.Printf("blockedMove: %q\n", info.(string))
 	}
-	return &Movement{Failed: true, Info: info.(string)}, nil
+	return &Movement{Failed: true, Info: strings.TrimSpace(string(c.text))}, nil
 }
 
 func (p *parser) callonblockedMove1() (any, error) {
@@ -3317,7 +4539,7 @@ func (c *current) onnotEnoughMP1(info any) (any, error) {
 	if debug {
 		log.Printf("notEnoughMP: %q\n", info.(string))
 	}
-	return &Movement{Failed: true, Info: info.(string)}, nil
+	return &Movement{Failed: true, Info: strings.TrimSpace(string(c.text))}, nil
 }
 
 func (p *parser) callonnotEnoughMP1() (any, error) {
@@ -3501,148 +4723,379 @@ func (p *parser) callonPossessions1() (any, error) {
 	return p.cur.onPossessions1(stack["animals"], stack["minerals"], stack["warEquipment"], stack["finishedGoods"], stack["rawMaterials"], stack["ships"])
 }
 
-func (c *current) onAnimals1() (any, error) {
+func (c *current) onAnimals1(itemsi, bleet any) (any, error) {
 	var o Animals
-	o.Bleet = string(c.text)
+	o.Items = toItems(itemsi)
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonAnimals1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAnimals1()
+	return p.cur.onAnimals1(stack["itemsi"], stack["bleet"])
 }
 
-func (c *current) onMinerals1() (any, error) {
+func (c *current) onMinerals1(itemsi, bleet any) (any, error) {
 	var o Minerals
-	o.Bleet = string(c.text)
+	o.Items = toItems(itemsi)
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonMinerals1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMinerals1()
+	return p.cur.onMinerals1(stack["itemsi"], stack["bleet"])
 }
 
-func (c *current) onWarEquipment1() (any, error) {
+func (c *current) onWarEquipment1(itemsi, bleet any) (any, error) {
 	var o WarEquipment
-	o.Bleet = string(c.text)
+	o.Items = toItems(itemsi)
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonWarEquipment1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onWarEquipment1()
+	return p.cur.onWarEquipment1(stack["itemsi"], stack["bleet"])
 }
 
-func (c *current) onFinishedGoods1() (any, error) {
+func (c *current) onFinishedGoods1(itemsi, bleet any) (any, error) {
 	var o FinishedGoods
-	o.Bleet = string(c.text)
+	o.Items = toItems(itemsi)
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonFinishedGoods1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onFinishedGoods1()
+	return p.cur.onFinishedGoods1(stack["itemsi"], stack["bleet"])
 }
 
-func (c *current) onRawMaterials1() (any, error) {
+func (c *current) onRawMaterials1(itemsi, bleet any) (any, error) {
 	var o RawMaterials
-	o.Bleet = string(c.text)
+	o.Items = toItems(itemsi)
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonRawMaterials1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onRawMaterials1()
+	return p.cur.onRawMaterials1(stack["itemsi"], stack["bleet"])
 }
 
-func (c *current) onShips1() (any, error) {
+func (c *current) onShips1(itemsi, bleet any) (any, error) {
 	var o Ships
-	o.Bleet = string(c.text)
+	o.Items = toItems(itemsi)
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonShips1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onShips1()
+	return p.cur.onShips1(stack["itemsi"], stack["bleet"])
+}
+
+func (c *current) onitemQuantity1(name, qty any) (any, error) {
+	n, err := atoiCommas(qty)
+	return &Item{Name: name.(string), Quantity: n}, err
+}
+
+func (p *parser) callonitemQuantity1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onitemQuantity1(stack["name"], stack["qty"])
+}
+
+func (c *current) onuntilMinerals1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilMinerals1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilMinerals1()
+}
+
+func (c *current) onuntilWarEquipment1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilWarEquipment1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilWarEquipment1()
+}
+
+func (c *current) onuntilFinishedGoods1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilFinishedGoods1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilFinishedGoods1()
+}
+
+func (c *current) onuntilRawMaterials1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilRawMaterials1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilRawMaterials1()
+}
+
+func (c *current) onuntilShips1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilShips1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilShips1()
+}
+
+func (c *current) onuntilSkills1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilSkills1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilSkills1()
 }
 
-func (c *current) onSkills1() (any, error) {
+func (c *current) onSkills1(levelsi, bleet any) (any, error) {
 	var o Skills
-	o.Bleet = string(c.text)
+	o.Levels = make(map[string]int)
+	for _, level := range toAnySlice(levelsi) {
+		if level, ok := level.(*Item); ok {
+			o.Levels[level.Name] = level.Quantity
+		}
+	}
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonSkills1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSkills1()
+	return p.cur.onSkills1(stack["levelsi"], stack["bleet"])
+}
+
+func (c *current) onskillLevel1(code, level any) (any, error) {
+	n, err := atoi(level)
+	return &Item{Name: code.(string), Quantity: n}, err
+}
+
+func (p *parser) callonskillLevel1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onskillLevel1(stack["code"], stack["level"])
+}
+
+func (c *current) onuntilMorale1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilMorale1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilMorale1()
 }
 
-func (c *current) onMorale1() (any, error) {
+func (c *current) onMorale1(value, bleet any) (any, error) {
 	var o Morale
-	o.Bleet = string(c.text)
+	var err error
+	if o.Value, err = strconv.ParseFloat(value.(string), 64); err != nil {
+		return &o, err
+	}
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonMorale1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMorale1()
+	return p.cur.onMorale1(stack["value"], stack["bleet"])
+}
+
+func (c *current) onuntilWeight1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilWeight1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilWeight1()
 }
 
-func (c *current) onWeight1() (any, error) {
+func (c *current) onWeight1(value, bleet any) (any, error) {
 	var o Weight
-	o.Bleet = string(c.text)
+	var err error
+	if o.Value, err = atoiCommas(value); err != nil {
+		return &o, err
+	}
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonWeight1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onWeight1()
+	return p.cur.onWeight1(stack["value"], stack["bleet"])
+}
+
+func (c *current) onuntilTrucesOrFF1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilTrucesOrFF1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilTrucesOrFF1()
 }
 
-func (c *current) onTruces1() (any, error) {
+func (c *current) onTruces1(trucesi, bleet any) (any, error) {
 	var o Truces
-	o.Bleet = string(c.text)
+	for _, truce := range toAnySlice(trucesi) {
+		if truce, ok := truce.(*Truce); ok {
+			o.Truces = append(o.Truces, truce)
+		}
+	}
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonTruces1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTruces1()
+	return p.cur.onTruces1(stack["trucesi"], stack["bleet"])
+}
+
+func (c *current) ontruce1(id, note any) (any, error) {
+	o := Truce{Unit: id.(string)}
+	if note != nil {
+		o.Note = note.(string)
+	}
+	return &o, nil
+}
+
+func (p *parser) callontruce1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ontruce1(stack["id"], stack["note"])
+}
+
+func (c *current) ontruceNote1() (any, error) {
+	return strings.TrimSpace(string(c.text[1 : len(c.text)-1])), nil
+}
+
+func (p *parser) callontruceNote1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ontruceNote1()
 }
 
-func (c *current) onTransfers1() (any, error) {
+func (c *current) onTransfers1(transfersi, bleet any) (any, error) {
 	var o Transfers
-	o.Bleet = string(c.text)
+	for _, line := range toAnySlice(transfersi) {
+		if line, ok := line.([]*Transfer); ok {
+			o.Transfers = append(o.Transfers, line...)
+		}
+	}
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonTransfers1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTransfers1()
+	return p.cur.onTransfers1(stack["transfersi"], stack["bleet"])
+}
+
+func (c *current) ontransferLine1(from, to, itemsi any) (any, error) {
+	var o []*Transfer
+	for _, item := range toAnySlice(itemsi) {
+		if item, ok := item.(*Item); ok {
+			o = append(o, &Transfer{From: from.(string), To: to.(string), Item: item.Name, Quantity: item.Quantity})
+		}
+	}
+	return o, nil
+}
+
+func (p *parser) callontransferLine1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ontransferLine1(stack["from"], stack["to"], stack["itemsi"])
+}
+
+func (c *current) ontransferItem1(qty, name any) (any, error) {
+	n, err := atoiCommas(qty)
+	return &Item{Name: name.(string), Quantity: n}, err
+}
+
+func (p *parser) callontransferItem1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.ontransferItem1(stack["qty"], stack["name"])
 }
 
-func (c *current) onSettlements1() (any, error) {
+func (c *current) onSettlements1(settlementsi, bleet any) (any, error) {
 	var o Settlements
-	o.Bleet = string(c.text)
+	for _, s := range toAnySlice(settlementsi) {
+		if s, ok := s.(*Settlement); ok {
+			o.Settlements = append(o.Settlements, s)
+		}
+	}
+	o.Bleet = strings.TrimSpace(bleet.(string))
 	return &o, nil
 }
 
 func (p *parser) callonSettlements1() (any, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSettlements1()
+	return p.cur.onSettlements1(stack["settlementsi"], stack["bleet"])
+}
+
+func (c *current) onsettlementLine1(hex, columns any) (any, error) {
+	o := Settlement{Hex: hex.(string)}
+	for i, column := range strings.Split(strings.TrimPrefix(columns.(string), "\t"), "\t") {
+		switch column = strings.TrimSpace(column); i {
+		case 0:
+			o.Name = column
+		case 1:
+			o.Note = column
+		case 2:
+			o.Type = column
+		case 3:
+			o.SubType = column
+		}
+	}
+	return &o, nil
+}
+
+func (p *parser) callonsettlementLine1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onsettlementLine1(stack["hex"], stack["columns"])
+}
+
+func (c *current) onuntilFF1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonuntilFF1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onuntilFF1()
 }
 
 func (c *current) oneatToEOL1() (any, error) {
@@ -3736,6 +5189,16 @@ func (p *parser) callonELEMENTID1() (any, error) {
 	return p.cur.onELEMENTID1()
 }
 
+func (c *current) onITEMNAME1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonITEMNAME1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onITEMNAME1()
+}
+
 func (c *current) onHEXID1() (any, error) {
 	return string(c.text), nil
 }
@@ -3776,6 +5239,16 @@ func (p *parser) callonOPTMOVEINFO1() (any, error) {
 	return p.cur.onOPTMOVEINFO1()
 }
 
+func (c *current) onQUANTITY1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonQUANTITY1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQUANTITY1()
+}
+
 func (c *current) onREST1() (any, error) {
 	rest := string(c.text)
 	return rest, nil
@@ -3825,6 +5298,16 @@ func (p *parser) callonTERRAIN1() (any, error) {
 	return p.cur.onTERRAIN1()
 }
 
+func (c *current) onSKILLCODE1() (any, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonSKILLCODE1() (any, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSKILLCODE1()
+}
+
 func (c *current) onTRIBEID1() (any, error) {
 	return string(c.text), nil
 }
@@ -13,6 +13,9 @@ type Report struct {
 
 	T map[string]*TribeReport `json:"units,omitempty"`
 
+	Transfers   *Transfers   `json:"transfers,omitempty"`
+	Settlements *Settlements `json:"settlements,omitempty"`
+
 	// Rest is all input after we hit our first error?
 	Rest string `json:"rest,omitempty"`
 }
@@ -45,7 +48,8 @@ type TribeReport struct {
 }
 
 type Animals struct {
-	Bleet string
+	Items map[string]int `json:"items,omitempty"`
+	Bleet string         `json:"bleet,omitempty"`
 }
 
 type CommonHeading struct {
@@ -63,7 +67,8 @@ type FinalActivities struct {
 }
 
 type FinishedGoods struct {
-	Bleet string `json:"bleet,omitempty"`
+	Items map[string]int `json:"items,omitempty"`
+	Bleet string         `json:"bleet,omitempty"`
 }
 
 type Humans struct {
@@ -71,11 +76,19 @@ type Humans struct {
 }
 
 type Minerals struct {
-	Bleet string `json:"bleet,omitempty"`
+	Items map[string]int `json:"items,omitempty"`
+	Bleet string         `json:"bleet,omitempty"`
+}
+
+// Item is an item name and quantity from the possessions and transfer sections.
+type Item struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
 }
 
 type Morale struct {
-	Bleet string `json:"bleet,omitempty"`
+	Value float64 `json:"value"`
+	Bleet string  `json:"bleet,omitempty"`
 }
 
 type Movement struct {
@@ -103,7 +116,8 @@ type Possessions struct {
 }
 
 type RawMaterials struct {
-	Bleet string `json:"bleet,omitempty"`
+	Items map[string]int `json:"items,omitempty"`
+	Bleet string         `json:"bleet,omitempty"`
 }
 
 type ScoutActions struct {
@@ -118,20 +132,41 @@ type ScoutMovement struct {
 	Bleet    string      `json:"bleet,omitempty"`
 }
 
+// Settlement is one line of the Settlements section.
+type Settlement struct {
+	Hex     string `json:"hex"`
+	Name    string `json:"name,omitempty"`
+	Note    string `json:"note,omitempty"`
+	Type    string `json:"type,omitempty"`
+	SubType string `json:"sub-type,omitempty"`
+}
+
 type Settlements struct {
-	Bleet string `json:"bleet,omitempty"`
+	Settlements []*Settlement `json:"settlements,omitempty"`
+	Bleet       string        `json:"bleet,omitempty"`
 }
 
 type Ships struct {
-	Bleet string `json:"bleet,omitempty"`
+	Items map[string]int `json:"items,omitempty"`
+	Bleet string         `json:"bleet,omitempty"`
 }
 
+// Skills maps the skill code to the skill level.
 type Skills struct {
-	Bleet string `json:"bleet,omitempty"`
+	Levels map[string]int `json:"levels,omitempty"`
+	Bleet  string         `json:"bleet,omitempty"`
+}
+
+type Transfer struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Item     string `json:"item"`
+	Quantity int    `json:"quantity"`
 }
 
 type Transfers struct {
-	Bleet string `json:"bleet,omitempty"`
+	Transfers []*Transfer `json:"transfers,omitempty"`
+	Bleet     string      `json:"bleet,omitempty"`
 }
 
 type TribeActivities struct {
@@ -144,8 +179,14 @@ type TribeMovement struct {
 	Bleet    string      `json:"bleet,omitempty"`
 }
 
+type Truce struct {
+	Unit string `json:"unit"`
+	Note string `json:"note,omitempty"`
+}
+
 type Truces struct {
-	Bleet string `json:"bleet,omitempty"`
+	Truces []*Truce `json:"truces,omitempty"`
+	Bleet  string   `json:"bleet,omitempty"`
 }
 
 type UnitStatus struct {
@@ -158,9 +199,11 @@ type UnitStatus struct {
 }
 
 type WarEquipment struct {
-	Bleet string `json:"bleet,omitempty"`
+	Items map[string]int `json:"items,omitempty"`
+	Bleet string         `json:"bleet,omitempty"`
 }
 
 type Weight struct {
+	Value int    `json:"value"`
 	Bleet string `json:"bleet,omitempty"`
 }
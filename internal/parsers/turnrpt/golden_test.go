@@ -0,0 +1,36 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package turnrpt
+
+import (
+	"github.com/mdhender/chief/internal/golden"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGolden(t *testing.T) {
+	testdata := filepath.Join("..", "..", "..", "testdata")
+	for _, path := range golden.Corpus(t, filepath.Join(testdata, "turn-reports")) {
+		name := golden.Name(path)
+		t.Run(name, func(t *testing.T) {
+			input, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rpt, err := ParseInput(filepath.Base(path), input)
+			var msg string
+			if err != nil {
+				msg = err.Error()
+			}
+			golden.Compare(t, filepath.Join(testdata, "golden", "parsers-turnrpt", name+".json"), struct {
+				Report *Report `json:"report"`
+				Error  string  `json:"error,omitempty"`
+			}{
+				Report: rpt,
+				Error:  msg,
+			})
+		})
+	}
+}
@@ -18,12 +18,19 @@ func Parse(filename string) (*Report, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer fp.Close()
 	var input []byte
 	if body, _, err := docconv.ConvertDocx(fp); err != nil {
 		return nil, err
 	} else {
 		input = []byte(body)
 	}
+	return ParseInput(filename, input)
+}
+
+// ParseInput parses the text of a turn report.
+// The filename is used only to label the report.
+func ParseInput(filename string, input []byte) (*Report, error) {
 	r := &Report{FileName: filename, input: input}
 
 	if err := r.parseClanStatus(); err != nil {
@@ -0,0 +1,36 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+// Package maps implements a JSON store for the merged world map.
+package maps
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/mdhender/chief/internal/tiles"
+	"os"
+)
+
+// ReadFile loads a map from a JSON file.
+func ReadFile(name string) (*tiles.Map, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("maps: read: %w", err)
+	}
+	m := tiles.New("")
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("maps: read: %s: %w", name, err)
+	}
+	return m, nil
+}
+
+// WriteFile saves a map to a JSON file.
+func WriteFile(name string, m *tiles.Map) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("maps: write: %w", err)
+	} else if err = os.WriteFile(name, data, 0644); err != nil {
+		return fmt.Errorf("maps: write: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package wxx
+
+import (
+	"github.com/mdhender/chief/internal/terrain"
+	"strings"
+)
+
+// terrainNames maps TribeNet terrain to the names of Worldographer's
+// classic terrain set. The index of the terrain in the file's terrain
+// map is the terrain.Terrain value.
+var terrainNames = []string{
+	terrain.Unknown: "Blank",
+	terrain.ALPS:    "Mountain Snowcapped",
+	terrain.AR:      "Flat Desert Rocky",
+	terrain.BH:      "Hills Shrubland",
+	terrain.BR:      "Flat Shrubland",
+	terrain.CH:      "Hills Forest Evergreen",
+	terrain.DE:      "Flat Desert Sandy",
+	terrain.DF:      "Flat Forest Deciduous",
+	terrain.DH:      "Hills Forest Deciduous",
+	terrain.FORDS:   "Water Shoals",
+	terrain.GH:      "Hills Grassland",
+	terrain.HSM:     "Mountains",
+	terrain.JG:      "Flat Forest Jungle",
+	terrain.JH:      "Hills Forest Jungle",
+	terrain.L:       "Water Lake",
+	terrain.LCM:     "Mountain Forest Evergreen",
+	terrain.LJM:     "Mountain Forest Jungle",
+	terrain.LSM:     "Mountains Snowcapped",
+	terrain.O:       "Water Sea",
+	terrain.PI:      "Flat Ice",
+	terrain.PR:      "Flat Grassland",
+	terrain.R:       "Water River",
+	terrain.RH:      "Hills Rocky",
+	terrain.SH:      "Hills Snow",
+	terrain.SW:      "Marsh",
+	terrain.TU:      "Flat Tundra",
+}
+
+// lookupTerrain returns the terrain for a Worldographer terrain name.
+// Names that we don't know, including Blank, return false.
+func lookupTerrain(name string) (terrain.Terrain, bool) {
+	for t, n := range terrainNames {
+		if t != int(terrain.Unknown) && strings.EqualFold(n, name) {
+			return terrain.Terrain(t), true
+		}
+	}
+	return terrain.Unknown, false
+}
@@ -0,0 +1,309 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+// Package wxx implements a store for Worldographer (.wxx) map files.
+//
+// A .wxx file is gzip-compressed XML, encoded as UTF-16. The map is a
+// "COLUMNS" map, which has the same layout as TribeNet: odd columns
+// (counting from 0) are shifted down half a hex. Tile 0, 0 in the file
+// is hex 0101 of the origin grid.
+package wxx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"github.com/mdhender/chief/internal/coords"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/terrain"
+	"github.com/mdhender/chief/internal/tiles"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	// hexWidth and hexHeight are Worldographer's default hex size.
+	hexWidth, hexHeight = 46.18, 40.0
+
+	// columnsPerGrid and rowsPerGrid are the size of a TribeNet grid.
+	columnsPerGrid, rowsPerGrid = coords.Columns, coords.Rows
+)
+
+// WriteFile exports the map to a Worldographer file. The file covers
+// every grid with a tile in the map. It returns the origin grid, which
+// is needed to import the file.
+//
+// Tiles in the hash ("##") grid can't be placed and are skipped.
+func WriteFile(name string, m *tiles.Map) (string, error) {
+	buf := bytes.Buffer{}
+	origin, err := Write(&buf, m)
+	if err != nil {
+		return "", err
+	} else if err = os.WriteFile(name, buf.Bytes(), 0644); err != nil {
+		return "", fmt.Errorf("wxx: write: %w", err)
+	}
+	return origin, nil
+}
+
+// Write exports the map to w. See WriteFile.
+func Write(w io.Writer, m *tiles.Map) (string, error) {
+	type placed struct {
+		tile *tiles.Tile
+		x, y int
+	}
+	var list []placed
+	minCol, minRow, maxCol, maxRow := 25, 25, 0, 0
+	for _, t := range m.Tiles() {
+		h, err := coords.Parse(t.Id())
+		if err != nil || !h.HasGrid() {
+			continue
+		}
+		col, row := int(h.Grid[1]-'A'), int(h.Grid[0]-'A')
+		minCol, maxCol = min(minCol, col), max(maxCol, col)
+		minRow, maxRow = min(minRow, row), max(maxRow, row)
+		x, y := h.Global()
+		list = append(list, placed{tile: t, x: x - 1, y: y - 1})
+	}
+	if len(list) == 0 {
+		return "", fmt.Errorf("wxx: write: no tiles with a known grid")
+	}
+	origin := string([]byte{byte('A' + minRow), byte('A' + minCol)})
+
+	wide, high := (maxCol-minCol+1)*columnsPerGrid, (maxRow-minRow+1)*rowsPerGrid
+	grid := make([][]terrain.Terrain, wide)
+	for x := range grid {
+		grid[x] = make([]terrain.Terrain, high)
+	}
+	for i := range list {
+		list[i].x, list[i].y = list[i].x-minCol*columnsPerGrid, list[i].y-minRow*rowsPerGrid
+		grid[list[i].x][list[i].y] = list[i].tile.Terrain
+	}
+
+	doc := bytes.Buffer{}
+	doc.WriteString("<?xml version='1.0' encoding='utf-16'?>\n")
+	doc.WriteString(`<map type="WORLD" version="1.74" lastViewLevel="WORLD" continentFactor="-1" kingdomFactor="-1" provinceFactor="-1" worldToContinentHOffset="0.0" continentToKingdomHOffset="0.0" kingdomToProvinceHOffset="0.0" worldToContinentVOffset="0.0" continentToKingdomVOffset="0.0" kingdomToProvinceVOffset="0.0" `)
+	doc.WriteString(fmt.Sprintf(`hexWidth="%.2f" hexHeight="%.2f" hexOrientation="COLUMNS" mapProjection="FLAT" showNotes="true" showGMOnly="false" showGMOnlyGlow="false" showFeatureLabels="true" showGrid="true" showGridNumbers="false" showShadows="true" triangleSize="12">`, hexWidth, hexHeight))
+	doc.WriteByte('\n')
+
+	// the index in the terrain map is the terrain code
+	doc.WriteString("<terrainmap>")
+	for t, name := range terrainNames {
+		if t > 0 {
+			doc.WriteByte('\t')
+		}
+		doc.WriteString(fmt.Sprintf("%s\t%d", name, t))
+	}
+	doc.WriteString("</terrainmap>\n")
+
+	// tiles are written a column at a time
+	doc.WriteString(fmt.Sprintf(`<tiles viewLevel="WORLD" tilesWide="%d" tilesHigh="%d">`, wide, high))
+	doc.WriteByte('\n')
+	for x := range grid {
+		doc.WriteString("<tilerow>\n")
+		for y := range grid[x] {
+			// terrain, elevation, icy, gm-only, resources
+			doc.WriteString(fmt.Sprintf("%d\t0\tfalse\tfalse\t0\tZ\n", grid[x][y]))
+		}
+		doc.WriteString("</tilerow>\n")
+	}
+	doc.WriteString("</tiles>\n")
+
+	doc.WriteString("<notes>\n")
+	for _, p := range list {
+		text := noteText(p.tile)
+		if text == "" {
+			continue
+		}
+		cx, cy := center(p.x, p.y)
+		doc.WriteString(fmt.Sprintf(`<note key="WORLD,%.1f,%.1f" viewLevel="WORLD" x="%.1f" y="%.1f" filename="" parent="null" color="1.0,1.0,0.0,1.0" title="%s">`, cx, cy, cx, cy, html.EscapeString(p.tile.Id())))
+		doc.WriteString(fmt.Sprintf("<notetext><![CDATA[%s]]></notetext></note>\n", strings.ReplaceAll(text, "]]>", "]] >")))
+	}
+	doc.WriteString("</notes>\n")
+	doc.WriteString("</map>\n")
+
+	// gzip the document as big-endian UTF-16 with a byte order mark
+	zw := gzip.NewWriter(w)
+	units := append([]uint16{0xFEFF}, utf16.Encode([]rune(doc.String()))...)
+	if err := binary.Write(zw, binary.BigEndian, units); err != nil {
+		return "", fmt.Errorf("wxx: write: %w", err)
+	} else if err = zw.Close(); err != nil {
+		return "", fmt.Errorf("wxx: write: %w", err)
+	}
+	return origin, nil
+}
+
+// noteText returns the note for the tile: the edges, the things found, the
+// settlements, and the last turn it was seen. It is empty if there is
+// nothing to say.
+func noteText(t *tiles.Tile) string {
+	var lines []string
+
+	// group the directions by the kind of edge
+	sides := map[edge.Edge][]string{}
+	for d, e := range t.Edges {
+		if e != edge.Unknown {
+			sides[e] = append(sides[e], tiles.Direction(d).String())
+		}
+	}
+	var kinds []edge.Edge
+	for e := range sides {
+		kinds = append(kinds, e)
+	}
+	sort.Slice(kinds, func(i, j int) bool { return kinds[i] < kinds[j] })
+	for _, e := range kinds {
+		lines = append(lines, fmt.Sprintf("%s: %s", e.Description(), strings.Join(sides[e], ", ")))
+	}
+
+	var found, settlements []string
+	for _, o := range t.Observations {
+		found = appendMissing(found, o.Found...)
+		settlements = appendMissing(settlements, o.Settlements...)
+	}
+	if len(found) != 0 {
+		lines = append(lines, "Found: "+strings.Join(found, ", "))
+	}
+	if len(settlements) != 0 {
+		lines = append(lines, "Settlements: "+strings.Join(settlements, ", "))
+	}
+	if len(lines) == 0 {
+		return ""
+	}
+	if turn := t.LastSeen(); turn != "" {
+		lines = append(lines, "Last seen: "+turn)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func appendMissing(list []string, values ...string) []string {
+	for _, v := range values {
+		if !slices.Contains(list, v) {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// center returns the center of the tile in Worldographer's coordinates.
+func center(x, y int) (float64, float64) {
+	cx, cy := float64(x)*hexWidth*0.75+hexWidth/2, float64(y)*hexHeight+hexHeight/2
+	if x%2 == 1 {
+		cy += hexHeight / 2
+	}
+	return cx, cy
+}
+
+// ReadFile adds the terrain from a Worldographer file to the map, so hexes
+// drawn by hand can seed it. Tile 0, 0 in the file is hex 0101 of the origin
+// grid. The observations have no turn, so anything in a turn report replaces
+// them. Blank tiles and terrain that we don't know are skipped.
+//
+// It returns the number of tiles added.
+func ReadFile(name, origin string, m *tiles.Map) (int, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return 0, fmt.Errorf("wxx: read: %w", err)
+	}
+	n, err := Read(bytes.NewReader(data), origin, filepath.Base(name), m)
+	if err != nil {
+		return 0, fmt.Errorf("wxx: read: %s: %w", name, err)
+	}
+	return n, nil
+}
+
+// Read adds the terrain from a Worldographer file to the map.
+// The source is saved in the observations. See ReadFile.
+func Read(r io.Reader, origin, source string, m *tiles.Map) (int, error) {
+	if len(origin) != 2 || !isUpper(origin[0]) || !isUpper(origin[1]) {
+		return 0, fmt.Errorf("invalid origin grid %q", origin)
+	}
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, err
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return 0, err
+	}
+	data = decodeUTF16(data)
+
+	var doc struct {
+		TerrainMap string `xml:"terrainmap"`
+		Tiles      struct {
+			Rows []string `xml:"tilerow"`
+		} `xml:"tiles"`
+	}
+	d := xml.NewDecoder(bytes.NewReader(data))
+	// the document is already decoded, whatever the declaration says
+	d.CharsetReader = func(_ string, r io.Reader) (io.Reader, error) { return r, nil }
+	if err := d.Decode(&doc); err != nil {
+		return 0, err
+	}
+
+	// the terrain map is a list of name, index pairs
+	index := map[int]terrain.Terrain{}
+	fields := strings.Split(doc.TerrainMap, "\t")
+	for i := 0; i+1 < len(fields); i += 2 {
+		n, err := strconv.Atoi(strings.TrimSpace(fields[i+1]))
+		if err != nil {
+			return 0, fmt.Errorf("terrainmap: %q: %w", fields[i+1], err)
+		}
+		if t, ok := lookupTerrain(strings.TrimSpace(fields[i])); ok {
+			index[n] = t
+		}
+	}
+
+	count := 0
+	gridRow, gridCol := int(origin[0]-'A'), int(origin[1]-'A')
+	for x, row := range doc.Tiles.Rows {
+		y := 0
+		for _, line := range strings.Split(row, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			n, err := strconv.Atoi(strings.Split(line, "\t")[0])
+			if err != nil {
+				return 0, fmt.Errorf("tile %d, %d: %w", x, y, err)
+			}
+			t, ok := index[n]
+			h, onMap := coords.FromGlobal(gridCol*columnsPerGrid+x+1, gridRow*rowsPerGrid+y+1)
+			if ok && onMap {
+				m.Observe(h.String(), &tiles.Observation{Source: source, Terrain: t})
+				count++
+			}
+			y++
+		}
+	}
+	return count, nil
+}
+
+// decodeUTF16 converts UTF-16 with a byte order mark to UTF-8.
+// Anything without a byte order mark is returned as is.
+func decodeUTF16(data []byte) []byte {
+	var order binary.ByteOrder
+	if len(data) >= 2 && data[0] == 0xFE && data[1] == 0xFF {
+		order = binary.BigEndian
+	} else if len(data) >= 2 && data[0] == 0xFF && data[1] == 0xFE {
+		order = binary.LittleEndian
+	} else {
+		return data
+	}
+	units := make([]uint16, (len(data)-2)/2)
+	for i := range units {
+		units[i] = order.Uint16(data[2+2*i:])
+	}
+	return []byte(string(utf16.Decode(units)))
+}
+
+func isUpper(ch byte) bool {
+	return 'A' <= ch && ch <= 'Z'
+}
@@ -0,0 +1,50 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package wxx
+
+import (
+	"bytes"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/terrain"
+	"github.com/mdhender/chief/internal/tiles"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	m := tiles.New("")
+	m.Observe("BC 0101", &tiles.Observation{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"N": edge.River}})
+	m.Observe("BC 3021", &tiles.Observation{Turn: "900-01", Terrain: terrain.O})
+	m.Observe("CD 1512", &tiles.Observation{Turn: "900-02", Terrain: terrain.LJM})
+
+	buf := bytes.Buffer{}
+	origin, err := Write(&buf, m)
+	if err != nil {
+		t.Fatalf("write: %v\n", err)
+	} else if origin != "BC" {
+		t.Errorf("origin: expected %q: got %q\n", "BC", origin)
+	}
+
+	seeded := tiles.New("")
+	n, err := Read(&buf, origin, "test.wxx", seeded)
+	if err != nil {
+		t.Fatalf("read: %v\n", err)
+	} else if n != 3 {
+		t.Errorf("read: expected 3 tiles: got %d\n", n)
+	}
+	for id, tc := range []struct {
+		gxy     string
+		terrain terrain.Terrain
+	}{
+		{"BC 0101", terrain.PR},
+		{"BC 3021", terrain.O},
+		{"CD 1512", terrain.LJM},
+	} {
+		tile := seeded.Observe(tc.gxy, &tiles.Observation{Source: "test.wxx", Terrain: tc.terrain})
+		if tile.Terrain != tc.terrain {
+			t.Errorf("%d: %s: expected %q: got %q\n", id, tc.gxy, tc.terrain, tile.Terrain)
+		} else if len(tile.Observations) != 1 {
+			t.Errorf("%d: %s: expected 1 observation: got %d\n", id, tc.gxy, len(tile.Observations))
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+// Package svg renders a board of flat-top hexes as an SVG image.
+//
+// The board is an offset grid of columns and rows starting at 0, 0.
+// It uses the TribeNet layout: columns with odd x (the even TribeNet
+// columns) are shifted down half a hex. The board is assumed to start
+// at the top left corner of a TribeNet grid, so coordinate labels and
+// grid boundaries line up with the turn reports.
+package svg
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mdhender/chief/internal/coords"
+	"github.com/mdhender/chief/internal/terrain"
+	"math"
+)
+
+const (
+	// RADIUS is the distance from the center of a hex to a corner.
+	RADIUS = 30
+)
+
+// SVG is a board of hexes.
+type SVG struct {
+	cols, rows     int
+	addCoordinates bool
+	hexes          []*hex
+}
+
+type hex struct {
+	x, y    int
+	terrain terrain.Terrain
+}
+
+// New returns an SVG for a board with the given number of columns and rows.
+// If addCoordinates is set, every hex is labeled with its TribeNet column and row.
+func New(cols, rows int, addCoordinates bool) *SVG {
+	return &SVG{cols: cols, rows: rows, addCoordinates: addCoordinates}
+}
+
+// AddHex adds a hex to the board. Hexes that are off the board are ignored.
+func (s *SVG) AddHex(x, y int, t terrain.Terrain) {
+	if !(0 <= x && x < s.cols && 0 <= y && y < s.rows) {
+		return
+	}
+	s.hexes = append(s.hexes, &hex{x: x, y: y, terrain: t})
+}
+
+// Bytes returns the SVG image. The hexes are drawn first, then the grid
+// boundaries, and then the coordinate labels.
+func (s *SVG) Bytes() []byte {
+	buf := bytes.Buffer{}
+
+	width, height := s.size()
+	buf.WriteString(fmt.Sprintf(`<svg viewBox="0 0 %d %d" xmlns="http://www.w3.org/2000/svg">`, int(math.Ceil(width)), int(math.Ceil(height))))
+	buf.WriteByte('\n')
+
+	for _, h := range s.hexes {
+		buf.WriteString(`<polygon points="`)
+		for i, p := range corners(h.x, h.y) {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			buf.WriteString(fmt.Sprintf("%.2f,%.2f", p.x, p.y))
+		}
+		buf.WriteString(fmt.Sprintf(`" fill="%s" stroke="grey" stroke-width="1">`, h.terrain.ToFill()))
+		if h.terrain != terrain.Unknown {
+			buf.WriteString(fmt.Sprintf(`<title>%s</title>`, h.terrain.Description()))
+		}
+		buf.WriteString(`</polygon>`)
+		buf.WriteByte('\n')
+	}
+
+	buf.Write(s.boundaries())
+
+	if s.addCoordinates {
+		for _, h := range s.hexes {
+			c := center(h.x, h.y)
+			buf.WriteString(fmt.Sprintf(`<text x="%.2f" y="%.2f" text-anchor="middle" fill="grey" font-size="10">%02d%02d</text>`, c.x, c.y+RADIUS/2, h.x%coords.Columns+1, h.y%coords.Rows+1))
+			buf.WriteByte('\n')
+		}
+	}
+
+	buf.WriteString("</svg>\n")
+	return buf.Bytes()
+}
+
+// boundaries returns the lines between hexes that are in different grids.
+// Each side is checked from one hex only, so it is drawn once.
+func (s *SVG) boundaries() []byte {
+	buf := bytes.Buffer{}
+	for x := 0; x < s.cols; x++ {
+		for y := 0; y < s.rows; y++ {
+			h, ok := boardHex(x, y)
+			if !ok {
+				continue
+			}
+			// NE, SE, and S; the other sides belong to the neighbors
+			for _, d := range []coords.Direction{coords.NE, coords.SE, coords.S} {
+				n, ok := h.Step(d)
+				if !ok || n.Grid == h.Grid {
+					continue
+				} else if col, row := n.Global(); col > s.cols || row > s.rows {
+					continue
+				}
+				from, to := sideCorners(x, y, d)
+				buf.WriteString(fmt.Sprintf(`<line x1="%.2f" y1="%.2f" x2="%.2f" y2="%.2f" stroke="black" stroke-width="3" />`, from.x, from.y, to.x, to.y))
+				buf.WriteByte('\n')
+			}
+		}
+	}
+	return buf.Bytes()
+}
+
+// size returns the width and height of the image.
+func (s *SVG) size() (width, height float64) {
+	width = 1.5*RADIUS*float64(s.cols) + RADIUS/2 + 2
+	height = math.Sqrt(3)*RADIUS*(float64(s.rows)+0.5) + 2
+	return width, height
+}
+
+type point struct {
+	x, y float64
+}
+
+// center returns the center of the hex on the screen.
+func center(x, y int) point {
+	h := math.Sqrt(3) * RADIUS
+	cy := h * (float64(y) + 0.5)
+	if x%2 == 1 {
+		cy += h / 2
+	}
+	return point{x: RADIUS + 1.5*RADIUS*float64(x) + 1, y: cy + 1}
+}
+
+// corners returns the corners of the hex, starting east and going clockwise.
+func corners(x, y int) []point {
+	c := center(x, y)
+	var list []point
+	for i := 0; i < 6; i++ {
+		angle := math.Pi / 3 * float64(i)
+		list = append(list, point{x: c.x + RADIUS*math.Cos(angle), y: c.y + RADIUS*math.Sin(angle)})
+	}
+	return list
+}
+
+// sideCorners returns the corners for a side of the hex.
+func sideCorners(x, y int, d coords.Direction) (point, point) {
+	c := corners(x, y)
+	// corner 0 is east and corner 5 is north-east
+	from := [6]int{5, 0, 1, 2, 3, 4}[d]
+	to := [6]int{4, 5, 0, 1, 2, 3}[d]
+	return c[from], c[to]
+}
+
+// boardHex returns the TribeNet hex for a hex on the board.
+// It returns false if the board is bigger than the map.
+func boardHex(x, y int) (coords.GridHex, bool) {
+	return coords.FromGlobal(x+1, y+1)
+}
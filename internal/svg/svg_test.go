@@ -0,0 +1,26 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package svg
+
+import (
+	"github.com/mdhender/chief/internal/coords"
+	"math"
+	"testing"
+)
+
+func TestSides(t *testing.T) {
+	// the side of a hex is halfway between the centers of the neighbors
+	for _, x := range []int{4, 5} {
+		h, _ := boardHex(x, 5)
+		for d := coords.N; d <= coords.NW; d++ {
+			n, _ := h.Step(d)
+			nx, ny := n.Global()
+			a, b := center(x, 5), center(nx-1, ny-1)
+			from, to := sideCorners(x, 5, d)
+			if math.Abs((a.x+b.x)/2-(from.x+to.x)/2) > 0.01 || math.Abs((a.y+b.y)/2-(from.y+to.y)/2) > 0.01 {
+				t.Errorf("%d, 5: side %s: not between the centers\n", x, d)
+			}
+		}
+	}
+}
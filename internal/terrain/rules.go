@@ -0,0 +1,245 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package terrain
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/mdhender/chief/internal/edge"
+	"os"
+	"strings"
+)
+
+// Transport is how a unit moves: on foot or with animals.
+type Transport int
+
+// enums for Transport
+const (
+	Foot Transport = iota
+	Horse
+	Elephant
+	Camel
+)
+
+var transportCodes = []string{Foot: "foot", Horse: "horse", Elephant: "elephant", Camel: "camel"}
+
+// LookupTransport returns the transport for a name like "horse" or "Horses".
+// It returns false if the transport is not known.
+func LookupTransport(s string) (Transport, bool) {
+	s = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(s)), "s")
+	for t, code := range transportCodes {
+		if code == s {
+			return Transport(t), true
+		}
+	}
+	return Foot, false
+}
+
+// String implements the fmt.Stringer interface.
+func (t Transport) String() string {
+	if t < Foot || Camel < t {
+		panic(fmt.Sprintf("assert(transport != %d)", t))
+	}
+	return transportCodes[t]
+}
+
+// Rules is the game mechanics for moving through terrain. The pathfinder,
+// the order simulator and the scout planner all use the same rules.
+type Rules struct {
+	// Allowance is the movement points a unit has each turn.
+	Allowance map[Transport]int
+	// Costs is the movement points to enter each terrain.
+	// Terrain that is missing or costs zero can't be entered.
+	Costs map[Transport]map[Terrain]int
+	// Seasons is added to the cost of entering the terrain in a season.
+	// The season is the name from the turn report, like "Winter".
+	Seasons map[string]map[Terrain]int
+	// Crossings is the extra cost to cross the edges between hexes.
+	Crossings edge.Crossings
+	// Placeholder is set for the built-in rules, which aren't from the
+	// rule book. Callers should say so when they use them.
+	Placeholder bool
+}
+
+// DefaultRules returns the rules we use when there isn't a rules file.
+// They are also in cmd/route/rules.example.json, to start a rules file from.
+//
+// None of these numbers come from the rule book. The foot costs are our
+// best estimates. The horse, elephant and camel tables and the season
+// modifiers are placeholders until we have better numbers, so Placeholder
+// is set; use a rules file to correct them.
+func DefaultRules() *Rules {
+	return &Rules{
+		Placeholder: true,
+		Allowance:   map[Transport]int{Foot: 15, Horse: 24, Elephant: 18, Camel: 21},
+		Costs: map[Transport]map[Terrain]int{
+			Foot: {
+				AR: 4, BH: 6, BR: 4, CH: 6, DE: 4, DF: 5, DH: 6, FORDS: 4, GH: 5, HSM: 12, JG: 7,
+				JH: 7, LCM: 10, LJM: 10, LSM: 10, PI: 6, PR: 3, R: 5, RH: 6, SH: 8, SW: 8, TU: 4,
+			},
+			// placeholder
+			Horse: {
+				AR: 3, BH: 5, BR: 3, CH: 6, DE: 3, DF: 5, DH: 6, FORDS: 3, GH: 4, HSM: 15, JG: 8,
+				JH: 8, LCM: 12, LJM: 12, LSM: 12, PI: 6, PR: 2, R: 4, RH: 6, SH: 8, SW: 10, TU: 3,
+			},
+			// placeholder
+			Elephant: {
+				AR: 4, BH: 5, BR: 3, CH: 5, DE: 4, DF: 4, DH: 5, FORDS: 3, GH: 4, HSM: 14, JG: 4,
+				JH: 5, LCM: 10, LJM: 9, LSM: 12, PI: 8, PR: 3, R: 4, RH: 6, SH: 9, SW: 6, TU: 5,
+			},
+			// placeholder
+			Camel: {
+				AR: 2, BH: 5, BR: 3, CH: 6, DE: 2, DF: 5, DH: 6, FORDS: 4, GH: 4, HSM: 12, JG: 8,
+				JH: 8, LCM: 10, LJM: 11, LSM: 11, PI: 8, PR: 3, R: 5, RH: 5, SH: 9, SW: 10, TU: 4,
+			},
+		},
+		// placeholders
+		Seasons: map[string]map[Terrain]int{
+			// snow slows everything down, and the hills and mountains the most
+			"winter": {
+				BH: 2, BR: 1, CH: 2, DF: 1, DH: 2, GH: 2, HSM: 3, LCM: 3, LJM: 2, LSM: 3,
+				PI: 2, PR: 1, RH: 2, SH: 3, TU: 2,
+			},
+			// the thaw floods the low ground
+			"spring": {FORDS: 1, R: 1, SW: 2},
+		},
+		Crossings: edge.DefaultCrossings(),
+	}
+}
+
+// ReadRules loads rules from a JSON file. The file has the whole table, so
+// nothing in it is a placeholder: a transport or terrain that isn't in the
+// file can't be used. Crossings that aren't in the file keep the defaults.
+func ReadRules(name string) (*Rules, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("rules: read: %w", err)
+	}
+	r := &Rules{Crossings: edge.DefaultCrossings()}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("rules: read: %s: %w", name, err)
+	}
+	return r, nil
+}
+
+// Cost returns the movement points to enter the terrain, or false if the
+// terrain can't be entered. The cost is never less than one point.
+func (r *Rules) Cost(t Terrain, season string, tr Transport) (int, bool) {
+	cost, ok := r.Costs[tr][t]
+	if !ok || cost <= 0 {
+		return 0, false
+	}
+	return max(cost+r.Seasons[strings.ToLower(season)][t], 1), true
+}
+
+// Table returns the cost to enter every terrain that can be entered.
+func (r *Rules) Table(season string, tr Transport) map[Terrain]int {
+	table := map[Terrain]int{}
+	for t := range r.Costs[tr] {
+		if cost, ok := r.Cost(t, season, tr); ok {
+			table[t] = cost
+		}
+	}
+	return table
+}
+
+// jsonRules is the rules file, keyed by names and codes.
+type jsonRules struct {
+	Allowance map[string]int            `json:"allowance,omitempty"`
+	Costs     map[string]map[string]int `json:"costs,omitempty"`
+	Seasons   map[string]map[string]int `json:"seasons,omitempty"`
+	Crossings edge.Crossings            `json:"crossings,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (r *Rules) MarshalJSON() ([]byte, error) {
+	jr := jsonRules{
+		Allowance: map[string]int{},
+		Costs:     map[string]map[string]int{},
+		Seasons:   map[string]map[string]int{},
+		Crossings: r.Crossings,
+	}
+	for tr, n := range r.Allowance {
+		jr.Allowance[tr.String()] = n
+	}
+	for tr, costs := range r.Costs {
+		jr.Costs[tr.String()] = terrainCodes(costs)
+	}
+	for season, costs := range r.Seasons {
+		jr.Seasons[season] = terrainCodes(costs)
+	}
+	return json.Marshal(jr)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Values in the JSON are added to the rules, replacing any that are
+// already there, so a file can override some of the defaults.
+func (r *Rules) UnmarshalJSON(b []byte) error {
+	var jr jsonRules
+	jr.Crossings = r.Crossings
+	if err := json.Unmarshal(b, &jr); err != nil {
+		return err
+	}
+	r.Crossings = jr.Crossings
+
+	if r.Allowance == nil {
+		r.Allowance = map[Transport]int{}
+	}
+	for name, n := range jr.Allowance {
+		tr, ok := LookupTransport(name)
+		if !ok {
+			return fmt.Errorf("allowance: unknown transport %q", name)
+		}
+		r.Allowance[tr] = n
+	}
+
+	if r.Costs == nil {
+		r.Costs = map[Transport]map[Terrain]int{}
+	}
+	for name, costs := range jr.Costs {
+		tr, ok := LookupTransport(name)
+		if !ok {
+			return fmt.Errorf("costs: unknown transport %q", name)
+		}
+		if r.Costs[tr] == nil {
+			r.Costs[tr] = map[Terrain]int{}
+		}
+		if err := mergeCodes(r.Costs[tr], costs); err != nil {
+			return fmt.Errorf("costs: %s: %w", name, err)
+		}
+	}
+
+	if r.Seasons == nil {
+		r.Seasons = map[string]map[Terrain]int{}
+	}
+	for name, costs := range jr.Seasons {
+		season := strings.ToLower(name)
+		if r.Seasons[season] == nil {
+			r.Seasons[season] = map[Terrain]int{}
+		}
+		if err := mergeCodes(r.Seasons[season], costs); err != nil {
+			return fmt.Errorf("seasons: %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func terrainCodes(costs map[Terrain]int) map[string]int {
+	m := map[string]int{}
+	for t, n := range costs {
+		m[t.Code()] = n
+	}
+	return m
+}
+
+func mergeCodes(costs map[Terrain]int, codes map[string]int) error {
+	for code, n := range codes {
+		t, ok := Lookup(code)
+		if !ok {
+			return fmt.Errorf("unknown terrain %q", code)
+		}
+		costs[t] = n
+	}
+	return nil
+}
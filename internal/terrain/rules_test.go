@@ -0,0 +1,164 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package terrain
+
+import (
+	"encoding/json"
+	"github.com/mdhender/chief/internal/edge"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRules(t *testing.T) {
+	// a rules file only needs the values that change
+	r := DefaultRules()
+	if err := json.Unmarshal([]byte(`{
+		"allowance": {"horses": 30},
+		"costs": {"foot": {"PR": 2, "O": 20, "ALPS": 0}},
+		"seasons": {"Winter": {"PR": 3}},
+		"crossings": {"RF": 2}
+	}`), r); err != nil {
+		t.Fatalf("unmarshal: %v\n", err)
+	}
+
+	for _, tc := range []struct {
+		id        int
+		terrain   Terrain
+		season    string
+		transport Transport
+		cost      int
+		ok        bool
+	}{
+		{1, PR, "", Foot, 2, true},
+		{2, PR, "Winter", Foot, 5, true},
+		{3, PR, "Spring", Foot, 2, true},
+		{4, GH, "", Foot, 5, true},
+		{5, GH, "winter", Foot, 7, true},
+		{6, O, "", Foot, 20, true},
+		{7, ALPS, "", Foot, 0, false},
+		{8, L, "", Foot, 0, false},
+		{9, PR, "", Horse, 2, true},
+		{10, SW, "Spring", Camel, 12, true},
+	} {
+		cost, ok := r.Cost(tc.terrain, tc.season, tc.transport)
+		if cost != tc.cost || ok != tc.ok {
+			t.Errorf("%d: expected %d, %v: got %d, %v\n", tc.id, tc.cost, tc.ok, cost, ok)
+		}
+	}
+	if r.Allowance[Horse] != 30 || r.Allowance[Foot] != DefaultRules().Allowance[Foot] {
+		t.Errorf("allowance: got %v\n", r.Allowance)
+	}
+
+	for _, tc := range []struct {
+		id    int
+		edges []edge.Edge
+		cost  int
+		ok    bool
+	}{
+		{1, nil, 0, true},
+		{2, []edge.Edge{edge.Unknown, edge.Prairie}, 0, true},
+		{3, []edge.Edge{edge.River}, 0, false},
+		{4, []edge.Edge{edge.River, edge.RiverFord}, 2, true},
+		{5, []edge.Edge{edge.OceanCoast, edge.RiverFord}, 0, false},
+	} {
+		cost, ok := r.Crossings.Cost(tc.edges...)
+		if cost != tc.cost || ok != tc.ok {
+			t.Errorf("crossing %d: expected %d, %v: got %d, %v\n", tc.id, tc.cost, tc.ok, cost, ok)
+		}
+	}
+
+	// the rules survive a round trip
+	data, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("marshal: %v\n", err)
+	}
+	rr := &Rules{}
+	if err := json.Unmarshal(data, rr); err != nil {
+		t.Fatalf("unmarshal: %v\n", err)
+	} else if cost, _ := rr.Cost(PR, "Winter", Foot); cost != 5 {
+		t.Errorf("round trip: expected 5: got %d\n", cost)
+	} else if cost, _ := rr.Crossings.Cost(edge.RiverFord); cost != 2 {
+		t.Errorf("round trip: expected 2: got %d\n", cost)
+	}
+}
+
+// TestDefaultRules checks that every transport can make at least one move
+// on its cheapest terrain in every season.
+func TestDefaultRules(t *testing.T) {
+	r := DefaultRules()
+	seasons := []string{""}
+	for season := range r.Seasons {
+		seasons = append(seasons, season)
+	}
+	for _, tr := range []Transport{Foot, Horse, Elephant, Camel} {
+		allowance, ok := r.Allowance[tr]
+		if !ok || allowance <= 0 {
+			t.Errorf("%s: expected an allowance: got %d\n", tr, allowance)
+			continue
+		}
+		for _, season := range seasons {
+			cheapest := 0
+			for _, cost := range r.Table(season, tr) {
+				if cheapest == 0 || cost < cheapest {
+					cheapest = cost
+				}
+			}
+			if cheapest == 0 {
+				t.Errorf("%s: %q: expected terrain that can be entered: got none\n", tr, season)
+			} else if cheapest > allowance {
+				t.Errorf("%s: %q: expected the cheapest move (%d) to fit the allowance (%d)\n", tr, season, cheapest, allowance)
+			}
+		}
+	}
+}
+
+func TestReadRules(t *testing.T) {
+	// the example file has the built-in rules
+	r, err := ReadRules(filepath.Join("..", "..", "cmd", "route", "rules.example.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Placeholder {
+		t.Errorf("example: expected rules from a file not to be placeholders\n")
+	}
+	got, _ := json.Marshal(r)
+	want, _ := json.Marshal(DefaultRules())
+	if string(got) != string(want) {
+		t.Errorf("example: expected the built-in rules\n\t%s\ngot\n\t%s\n", want, got)
+	}
+
+	// a file has the whole table, except for the crossings
+	name := filepath.Join(t.TempDir(), "rules.json")
+	if err := os.WriteFile(name, []byte(`{"allowance": {"foot": 12}, "costs": {"foot": {"PR": 2}}, "crossings": {"RF": 2}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if r, err = ReadRules(name); err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []struct {
+		id        int
+		terrain   Terrain
+		transport Transport
+		cost      int
+		ok        bool
+	}{
+		{1, PR, Foot, 2, true},
+		{2, GH, Foot, 0, false},
+		{3, PR, Horse, 0, false},
+	} {
+		cost, ok := r.Cost(tc.terrain, "Winter", tc.transport)
+		if cost != tc.cost || ok != tc.ok {
+			t.Errorf("%d: expected %d, %v: got %d, %v\n", tc.id, tc.cost, tc.ok, cost, ok)
+		}
+	}
+	if _, ok := r.Allowance[Horse]; ok || r.Allowance[Foot] != 12 {
+		t.Errorf("allowance: expected only foot: got %v\n", r.Allowance)
+	}
+	if cost, _ := r.Crossings.Cost(edge.RiverFord); cost != 2 {
+		t.Errorf("crossings: expected a ford to cost 2: got %d\n", cost)
+	} else if _, ok := r.Crossings.Cost(edge.River); ok {
+		t.Errorf("crossings: expected the default for rivers\n")
+	}
+}
@@ -96,6 +96,37 @@ var (
 		SW:    "Swamp",
 		TU:    "Tundra",
 	}
+	// Fills are the colors for the terrain on the map.
+	// Water is blue, grass is green, forests are darker green,
+	// hills are brown, and snow and ice are white.
+	Fills = []string{
+		Unknown: "#f0f0f0",
+		ALPS:    "#f8f8ff",
+		AR:      "#e3c98f",
+		BH:      "#a0966a",
+		BR:      "#9fb270",
+		CH:      "#4f6f3a",
+		DE:      "#f2d99c",
+		DF:      "#3f8f3f",
+		DH:      "#6b8e3a",
+		FORDS:   "#8ecae6",
+		GH:      "#a8c060",
+		HSM:     "#7a6a5a",
+		JG:      "#1f6f2f",
+		JH:      "#3f7f3f",
+		L:       "#6fa8dc",
+		LCM:     "#5a6a4a",
+		LJM:     "#4a6a3a",
+		LSM:     "#c8c8d0",
+		O:       "#1f5fa0",
+		PI:      "#e8f4f8",
+		PR:      "#c8e08a",
+		R:       "#4f8fd0",
+		RH:      "#a08060",
+		SH:      "#e0e0e8",
+		SW:      "#7f9f6f",
+		TU:      "#b8b890",
+	}
 	LongDescription = [...]string{
 		ALPS:  "a bigger version of HSM.",
 		AR:    "Arid: tundra without water.",
@@ -166,8 +197,12 @@ func (c Terrain) String() string {
 	return Codes[c]
 }
 
+// ToFill returns the color used to fill a hex with the terrain.
 func (c Terrain) ToFill() string {
-	return "**fill**"
+	if c < Unknown || endOfCodes <= c {
+		panic(fmt.Sprintf("assert(terrain != %d)", c))
+	}
+	return Fills[c]
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface
@@ -183,6 +218,13 @@ func (c *Terrain) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Lookup returns the terrain for a code or long name, like "PR" or "PRAIRIE".
+// It returns false if the terrain is not known.
+func Lookup(s string) (Terrain, bool) {
+	c, _ := unmarshalCode(strings.ToUpper(strings.TrimSpace(s)))
+	return c, c != Unknown
+}
+
 func unmarshalCode(s string) (Terrain, bool) {
 	if c, ok := slices.BinarySearch(Codes, s); ok {
 		return Terrain(c), true
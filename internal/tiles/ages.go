@@ -0,0 +1,190 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+)
+
+// turnNumber converts a turn like "901-04" to the number of months since
+// the start of the game. It returns false if the turn isn't "YYY-MM".
+func turnNumber(turn string) (int, bool) {
+	if len(turn) != 6 || turn[3] != '-' {
+		return 0, false
+	}
+	year, err := strconv.Atoi(turn[:3])
+	if err != nil {
+		return 0, false
+	}
+	month, err := strconv.Atoi(turn[4:])
+	if err != nil || month < 1 || month > 12 {
+		return 0, false
+	}
+	return year*12 + month - 1, true
+}
+
+// Age returns the number of turns since the tile was last seen.
+// It returns false if the tile was never seen in a turn report.
+func (t *Tile) Age(current string) (int, bool) {
+	now, ok := turnNumber(current)
+	if !ok {
+		return 0, false
+	}
+	seen, ok := turnNumber(t.LastSeen())
+	if !ok {
+		return 0, false
+	}
+	return max(now-seen, 0), true
+}
+
+// Stale is a hex near one of our units and how long ago it was seen.
+type Stale struct {
+	Id       string
+	Unit     string // the closest unit
+	Distance int    // number of hexes from the unit
+	LastSeen string // empty if the hex was never seen
+	Age      int    // turns since the hex was seen, -1 if never
+}
+
+// Stalest returns the hexes within radius of the units, oldest first.
+// Hexes that were never seen come first, then the ones seen longest ago.
+// Ties go to the hex closest to a unit. Units is a map of unit id to the
+// hex it is in. If limit is more than zero, at most limit hexes are returned.
+func (m *Map) Stalest(current string, units map[string]string, radius, limit int) []*Stale {
+	var ids []string
+	for id := range units {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	near := map[string]*Stale{}
+	for _, unit := range ids {
+		from := m.MakeTile(units[unit]).Hex
+		for distance := 0; distance <= radius; distance++ {
+			for _, h := range from.Ring(distance) {
+				id, ok := gxyOf(h)
+				if !ok {
+					continue
+				}
+				if st, ok := near[id]; ok && st.Distance <= distance {
+					continue
+				}
+				st := &Stale{Id: id, Unit: unit, Distance: distance, Age: -1}
+				if t, ok := m.tiles[id]; ok {
+					st.LastSeen = t.LastSeen()
+					if age, ok := t.Age(current); ok {
+						st.Age = age
+					}
+				}
+				near[id] = st
+			}
+		}
+	}
+
+	var list []*Stale
+	for _, st := range near {
+		list = append(list, st)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if (a.Age < 0) != (b.Age < 0) {
+			return a.Age < 0
+		} else if a.Age != b.Age {
+			return a.Age > b.Age
+		} else if a.Distance != b.Distance {
+			return a.Distance < b.Distance
+		}
+		return a.Id < b.Id
+	})
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+	return list
+}
+
+// ageBands are the shades for the turns since a hex was seen.
+// Older hexes are faded more.
+var ageBands = []struct {
+	label   string
+	max     int
+	opacity float64
+}{
+	{"seen this turn", 0, 0},
+	{"1-2 turns ago", 2, 0.2},
+	{"3-5 turns ago", 5, 0.4},
+	{"6-11 turns ago", 11, 0.6},
+	{"12+ turns ago or never", math.MaxInt, 0.8},
+}
+
+// ageOpacity returns the opacity of the shade for the tile.
+func ageOpacity(age int, ok bool) float64 {
+	if ok {
+		for _, band := range ageBands {
+			if age <= band.max {
+				return band.opacity
+			}
+		}
+	}
+	return ageBands[len(ageBands)-1].opacity
+}
+
+// ShadeByAge fades every hex by the number of turns since it was last seen,
+// with the current turn as the reference. A legend of the shades is added.
+func (s *SVG) ShadeByAge(current string) {
+	s.ageTurn = current
+}
+
+// agesBytes returns the shade over every hex.
+func (s *SVG) agesBytes() []byte {
+	if s.ageTurn == "" {
+		return nil
+	}
+	buf := bytes.Buffer{}
+	buf.WriteString(`<g class="ages" fill="white" stroke="none">`)
+	buf.WriteByte('\n')
+	for _, poly := range s.polygons {
+		age, ok := poly.tile.Age(s.ageTurn)
+		opacity := ageOpacity(age, ok)
+		if opacity == 0 {
+			continue
+		}
+		buf.WriteString(fmt.Sprintf(`<polygon fill-opacity="%.1f" points="`, opacity))
+		for i, pt := range poly.points {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			buf.Write(pt.Bytes())
+		}
+		title := fmt.Sprintf("%s: never seen", poly.tile.Id())
+		if ok {
+			title = fmt.Sprintf("%s: last seen %s (%d turns ago)", poly.tile.Id(), poly.tile.LastSeen(), age)
+		}
+		buf.WriteString(fmt.Sprintf(`"><title>%s</title></polygon>`, title))
+		buf.WriteByte('\n')
+	}
+	buf.WriteString(`</g>`)
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+// ageLegendBytes returns the legend for the shades, starting at x, y.
+func ageLegendBytes(x, y float64) []byte {
+	buf := bytes.Buffer{}
+	buf.WriteString(`<g class="legend">`)
+	buf.WriteByte('\n')
+	for i, band := range ageBands {
+		ly := y + float64(i*legendHeight)
+		buf.WriteString(fmt.Sprintf(`<rect x="%f" y="%f" width="%d" height="8" fill="grey" />`, x, ly-4, EDGE))
+		buf.WriteString(fmt.Sprintf(`<rect x="%f" y="%f" width="%d" height="8" fill="white" fill-opacity="%.1f" />`, x, ly-4, EDGE, band.opacity))
+		buf.WriteString(fmt.Sprintf(`<text x="%f" y="%f" fill="black" font-size="8" dominant-baseline="middle">%s</text>`, x+EDGE+4, ly, band.label))
+		buf.WriteByte('\n')
+	}
+	buf.WriteString(`</g>`)
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
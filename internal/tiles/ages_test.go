@@ -0,0 +1,60 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"github.com/mdhender/chief/internal/terrain"
+	"testing"
+)
+
+func TestAge(t *testing.T) {
+	m := New("AA")
+	m.Observe("AA 0505", &Observation{Turn: "900-11", Terrain: terrain.PR})
+	m.Observe("AA 0506", &Observation{Turn: "901-02", Terrain: terrain.PR})
+	m.Observe("AA 0507", &Observation{Source: "hand.wxx", Terrain: terrain.PR})
+	for _, tc := range []struct {
+		id      int
+		gxy     string
+		current string
+		age     int
+		ok      bool
+	}{
+		{1, "AA 0505", "900-11", 0, true},
+		{2, "AA 0505", "901-02", 3, true},
+		{3, "AA 0506", "901-02", 0, true},
+		{4, "AA 0507", "901-02", 0, false},
+		{5, "AA 0505", "", 0, false},
+	} {
+		age, ok := m.tiles[tc.gxy].Age(tc.current)
+		if age != tc.age || ok != tc.ok {
+			t.Errorf("%d: expected %d, %v: got %d, %v\n", tc.id, tc.age, tc.ok, age, ok)
+		}
+	}
+}
+
+func TestStalest(t *testing.T) {
+	m := New("AA")
+	m.Observe("AA 0505", &Observation{Turn: "900-02", Terrain: terrain.PR})
+	m.Observe("AA 0605", &Observation{Turn: "900-01", Terrain: terrain.PR})
+
+	// radius 1 is the unit's hex and its six neighbors
+	list := m.Stalest("900-02", map[string]string{"0138": "AA 0505"}, 1, 0)
+	if len(list) != 7 {
+		t.Fatalf("stalest: want 7, got %d\n", len(list))
+	}
+	if list[0].Age != -1 || list[0].Distance != 1 {
+		t.Errorf("stalest: want a hex never seen first, got %+v\n", *list[0])
+	}
+	if st := list[5]; st.Id != "AA 0605" || st.Age != 1 || st.LastSeen != "900-01" {
+		t.Errorf("stalest: want AA 0605 seen 1 turn ago, got %+v\n", *st)
+	}
+	if st := list[6]; st.Id != "AA 0505" || st.Age != 0 || st.Distance != 0 {
+		t.Errorf("stalest: want AA 0505 last, got %+v\n", *st)
+	}
+
+	// hexes off the top left corner of the world are skipped
+	if got := len(m.Stalest("900-02", map[string]string{"0138": "AA 0101"}, 1, 0)); got != 3 {
+		t.Errorf("corner: want 3, got %d\n", got)
+	}
+}
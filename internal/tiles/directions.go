@@ -3,29 +3,25 @@
 
 package tiles
 
+import (
+	"github.com/mdhender/chief/internal/coords"
+)
+
 // Direction is a direction out of the hex.
-type Direction int
+type Direction = coords.Direction
 
 // enums for Direction
 const (
-	N Direction = iota
-	NE
-	SE
-	S
-	SW
-	NW
+	N  = coords.N
+	NE = coords.NE
+	SE = coords.SE
+	S  = coords.S
+	SW = coords.SW
+	NW = coords.NW
 )
 
-// Add returns a new direction.
-// When n is positive, it is clockwise.
-// Negative is counter-clockwise.
-func (d Direction) Add(n int) Direction {
-	return Direction(modulo(int(d)+n, 6))
-}
-
-// Subtract returns a new direction.
-// When n is positive, it is counter-clockwise.
-// Negative is clockwise.
-func (d Direction) Subtract(n int) Direction {
-	return Direction(modulo(int(d)-n, 6))
+// LookupDirection returns the direction for a code like "N" or "SE".
+// It returns false if the code is not a direction.
+func LookupDirection(s string) (Direction, bool) {
+	return coords.LookupDirection(s)
 }
@@ -0,0 +1,155 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mdhender/chief/internal/edge"
+)
+
+// edgeStyle is how an edge is drawn on the side of a hex.
+type edgeStyle struct {
+	stroke string
+	width  string
+	dash   string // stroke-dasharray, empty for a solid line
+}
+
+var (
+	// edgeStyles gives every kind of edge a distinct stroke.
+	// Rivers and fords are blue, the coast is navy, and the
+	// hill ridges are dotted in the color of the hills.
+	edgeStyles = map[edge.Edge]edgeStyle{
+		edge.ConiferHills: {stroke: "#2e5e2e", width: "2px", dash: "1,2"},
+		edge.GrassyHills:  {stroke: "#8db600", width: "2px", dash: "1,2"},
+		edge.OceanCoast:   {stroke: "#00264d", width: "4px"},
+		edge.Prairie:      {stroke: "#d4c26a", width: "1.5px", dash: "6,2"},
+		edge.River:        {stroke: "#3a7bd5", width: "3px"},
+		edge.RiverFord:    {stroke: "#3a7bd5", width: "3px", dash: "4,3"},
+		edge.RockyHills:   {stroke: "#8b5a2b", width: "2px", dash: "1,2"},
+		edge.Swamp:        {stroke: "#556b2f", width: "2px", dash: "2,2,6,2"},
+	}
+
+	// edgeOrder is the order of the legend. When the hexes on each
+	// side of an edge disagree, the edge first in the order is drawn.
+	edgeOrder = []edge.Edge{
+		edge.RiverFord,
+		edge.River,
+		edge.OceanCoast,
+		edge.RockyHills,
+		edge.ConiferHills,
+		edge.GrassyHills,
+		edge.Swamp,
+		edge.Prairie,
+	}
+
+	// sideCorners is the pair of polygon corners for each side of the hex.
+	// Corner 0 is east and the corners go counter-clockwise on the screen.
+	sideCorners = [6][2]int{
+		N:  {1, 2},
+		NE: {0, 1},
+		SE: {5, 0},
+		S:  {4, 5},
+		SW: {3, 4},
+		NW: {2, 3},
+	}
+)
+
+// side is one edge on the screen, shared by the hexes on either side.
+type side struct {
+	from, to point
+	edge     edge.Edge
+}
+
+// sides returns the edges of the polygons, once per side of the hex.
+// Both hexes of a side may report the edge; the corners are the same
+// for both, so they are matched by the midpoint of the side.
+// The overview doesn't have edges.
+func (s *SVG) sides() []*side {
+	if s.overview {
+		// the hexes are too small to see the edges
+		return nil
+	}
+	var list []*side
+	seen := map[string]*side{}
+	for _, poly := range s.polygons {
+		for d, e := range poly.edges {
+			if e == edge.Unknown || len(poly.points) != 6 {
+				continue
+			}
+			from, to := poly.points[sideCorners[d][0]], poly.points[sideCorners[d][1]]
+			key := fmt.Sprintf("%.1f,%.1f", (from.x+to.x)/2, (from.y+to.y)/2)
+			if sd, ok := seen[key]; !ok {
+				sd = &side{from: from, to: to, edge: e}
+				seen[key] = sd
+				list = append(list, sd)
+			} else if edgeRank(e) < edgeRank(sd.edge) {
+				sd.edge = e
+			}
+		}
+	}
+	return list
+}
+
+// edgeRank returns the position of the edge in the legend.
+func edgeRank(e edge.Edge) int {
+	for i, o := range edgeOrder {
+		if o == e {
+			return i
+		}
+	}
+	return len(edgeOrder)
+}
+
+// edgesBytes returns the lines for the edges of every hex.
+func edgesBytes(sides []*side) []byte {
+	buf := bytes.Buffer{}
+	for _, sd := range sides {
+		buf.WriteString(fmt.Sprintf(`<line class="edge-%s" x1="%f" y1="%f" x2="%f" y2="%f"%s />`, sd.edge.Code(), sd.from.x, sd.from.y, sd.to.x, sd.to.y, edgeStyles[sd.edge].attrs()))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// legendKinds returns the kinds of edges drawn, in legend order.
+func legendKinds(sides []*side) []edge.Edge {
+	var kinds []edge.Edge
+	for _, e := range edgeOrder {
+		for _, sd := range sides {
+			if sd.edge == e {
+				kinds = append(kinds, e)
+				break
+			}
+		}
+	}
+	return kinds
+}
+
+// legendHeight is the height of one row of the legend.
+const legendHeight = 12
+
+// legendBytes returns the legend for the kinds of edges, starting at x, y.
+func legendBytes(kinds []edge.Edge, x, y float64) []byte {
+	buf := bytes.Buffer{}
+	buf.WriteString(`<g class="legend">`)
+	buf.WriteByte('\n')
+	for i, e := range kinds {
+		ly := y + float64(i*legendHeight)
+		buf.WriteString(fmt.Sprintf(`<line x1="%f" y1="%f" x2="%f" y2="%f"%s />`, x, ly, x+EDGE, ly, edgeStyles[e].attrs()))
+		buf.WriteString(fmt.Sprintf(`<text x="%f" y="%f" fill="black" font-size="8" dominant-baseline="middle">%s</text>`, x+EDGE+4, ly, e.Description()))
+		buf.WriteByte('\n')
+	}
+	buf.WriteString(`</g>`)
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+// attrs returns the SVG attributes for the style.
+func (es edgeStyle) attrs() string {
+	s := fmt.Sprintf(` stroke="%s" stroke-width="%s" stroke-linecap="round"`, es.stroke, es.width)
+	if es.dash != "" {
+		s += fmt.Sprintf(` stroke-dasharray="%s"`, es.dash)
+	}
+	return s
+}
@@ -0,0 +1,37 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"github.com/mdhender/chief/internal/edge"
+	"math"
+	"testing"
+)
+
+func TestSides(t *testing.T) {
+	// an edge reported from both hexes is drawn once, on the side between them
+	for _, id := range []string{"AA 0505", "AA 0605"} {
+		for d := N; d <= NW; d++ {
+			m := New("AA")
+			from := m.MakeTile(id)
+			to := m.Neighbor(from, d)
+			from.Edges[d], to.Edges[d.Add(3)] = edge.River, edge.RiverFord
+
+			s := NewSVG(false)
+			s.AddTile(from)
+			s.AddTile(to)
+			sides := s.sides()
+			if len(sides) != 1 {
+				t.Errorf("%s %s: want 1 side, got %d\n", id, d, len(sides))
+				continue
+			} else if sides[0].edge != edge.RiverFord {
+				t.Errorf("%s %s: want %s, got %s\n", id, d, edge.RiverFord, sides[0].edge)
+			}
+			a, b, sd := s.polygons[0], s.polygons[1], sides[0]
+			if math.Abs((a.cx+b.cx)/2-(sd.from.x+sd.to.x)/2) > 0.01 || math.Abs((a.cy+b.cy)/2-(sd.from.y+sd.to.y)/2) > 0.01 {
+				t.Errorf("%s %s: side is not between %s and %s\n", id, d, from.Id(), to.Id())
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/edge"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Finding is a problem found while checking the map or the reports.
+type Finding struct {
+	Kind string `json:"kind"`
+	Hex  string `json:"hex,omitempty"`
+	Turn string `json:"turn,omitempty"`
+	Unit string `json:"unit,omitempty"`
+	Text string `json:"text"`
+}
+
+// Kinds of findings.
+const (
+	// TerrainConflict is a hex reported with different terrain.
+	TerrainConflict = "terrain-conflict"
+	// EdgeConflict is a side of a hex reported with different edges.
+	EdgeConflict = "edge-conflict"
+	// NeighborConflict is a side reported differently by the hexes on either side.
+	NeighborConflict = "neighbor-conflict"
+	// RiverCrossing is a successful move across a river without a ford.
+	RiverCrossing = "river-crossing"
+	// EndMismatch is a unit whose moves end in a different hex than the report.
+	EndMismatch = "end-mismatch"
+	// StatusMismatch is a unit whose status line doesn't match the moves.
+	StatusMismatch = "status-mismatch"
+	// BadReport is data in the report that can't be mapped.
+	BadReport = "bad-report"
+)
+
+// String implements the fmt.Stringer interface.
+func (f *Finding) String() string {
+	var where []string
+	for _, s := range []string{f.Turn, f.Unit, f.Hex} {
+		if s != "" {
+			where = append(where, s)
+		}
+	}
+	return fmt.Sprintf("%s: %s: %s", f.Kind, strings.Join(where, " "), f.Text)
+}
+
+// SortFindings sorts the findings by kind, hex, turn and unit.
+func SortFindings(list []*Finding) {
+	sort.SliceStable(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		} else if a.Hex != b.Hex {
+			return a.Hex < b.Hex
+		} else if a.Turn != b.Turn {
+			return a.Turn < b.Turn
+		}
+		return a.Unit < b.Unit
+	})
+}
+
+// Check returns the contradictions in the observations on the map:
+// hexes reported with different terrain or edges, and sides where the
+// hexes on either side report different edges. A river and a ford on the
+// same side is not a contradiction, in one hex or from either side, since
+// fords aren't always reported.
+func (m *Map) Check() []*Finding {
+	var list []*Finding
+	for _, t := range m.Tiles() {
+		if c := t.Conflicts(); c != nil {
+			if c.Terrain != nil {
+				list = append(list, &Finding{Kind: TerrainConflict, Hex: t.id, Text: fmt.Sprintf("reported as %s", t.reporters(c))})
+			}
+			for d := N; d <= NW; d++ {
+				if edges, ok := c.Edges[d]; ok && !edgesAgree(edges...) {
+					list = append(list, &Finding{Kind: EdgeConflict, Hex: t.id, Text: fmt.Sprintf("%s reported as %s", d, edgeNames(edges))})
+				}
+			}
+		}
+
+		// the N, NE and SE sides of every hex cover each side once
+		for d := N; d <= SE; d++ {
+			e := t.Edges[d]
+			if e == edge.Unknown {
+				continue
+			}
+			n := m.lookupNeighbor(t, d)
+			if n == nil {
+				continue
+			}
+			ne := n.Edges[d.Add(3)]
+			if ne == edge.Unknown || edgesAgree(e, ne) {
+				continue
+			}
+			list = append(list, &Finding{Kind: NeighborConflict, Hex: t.id, Text: fmt.Sprintf("%s is %s, but %s reports %s to the %s", d, e.Description(), n.id, ne.Description(), d.Add(3))})
+		}
+	}
+	return list
+}
+
+// CheckTracks returns the moves in the tracks that went across a river
+// without a ford, according to the map.
+func (m *Map) CheckTracks(tracks []*Track) []*Finding {
+	var list []*Finding
+	for _, tr := range tracks {
+		for i := 1; i < len(tr.Hexes); i++ {
+			from, to := m.Lookup(tr.Hexes[i-1]), m.Lookup(tr.Hexes[i])
+			if from == nil || to == nil {
+				continue
+			}
+			for d := N; d <= NW; d++ {
+				if from.Hex.Neighbor(d) != to.Hex {
+					continue
+				}
+				edges := SideEdges(from, to, d)
+				if slices.Contains(edges, edge.River) && !slices.Contains(edges, edge.RiverFord) {
+					list = append(list, &Finding{Kind: RiverCrossing, Hex: from.id, Turn: tr.Turn, Unit: tr.Name(), Text: fmt.Sprintf("moved %s to %s across a river with no ford", d, to.id)})
+				}
+				break
+			}
+		}
+	}
+	return list
+}
+
+// lookupNeighbor returns the tile next to the tile in the direction,
+// or nil if the map doesn't have it.
+func (m *Map) lookupNeighbor(t *Tile, d Direction) *Tile {
+	id, ok := gxyOf(t.Hex.Neighbor(d))
+	if !ok {
+		return nil
+	}
+	return m.tiles[id]
+}
+
+// reporters returns the terrains in the conflict and the first turn and
+// unit that reported each one, like "PR (900-01 0138), GH (900-02 0138e1)".
+func (t *Tile) reporters(c *Conflict) string {
+	var list []string
+	for _, ct := range c.Terrain {
+		for _, o := range t.Observations {
+			if o.Terrain != ct {
+				continue
+			}
+			who := strings.TrimSpace(o.Turn + " " + o.Unit)
+			if who == "" {
+				who = o.Source
+			}
+			list = append(list, fmt.Sprintf("%s (%s)", ct.Code(), who))
+			break
+		}
+	}
+	return strings.Join(list, ", ")
+}
+
+// edgeNames returns the descriptions of the edges, like "River, River Fords".
+func edgeNames(edges []edge.Edge) string {
+	var list []string
+	for _, e := range edges {
+		list = append(list, e.Description())
+	}
+	return strings.Join(list, ", ")
+}
+
+// edgesAgree returns true if the edges reported for a side don't
+// contradict each other. A river and a ford agree, since the ford
+// isn't always reported.
+func edgesAgree(edges ...edge.Edge) bool {
+	for _, a := range edges {
+		for _, b := range edges {
+			if a != b && !(a == edge.River && b == edge.RiverFord) && !(a == edge.RiverFord && b == edge.River) {
+				return false
+			}
+		}
+	}
+	return true
+}
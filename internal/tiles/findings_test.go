@@ -0,0 +1,72 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/terrain"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	for _, tc := range []struct {
+		id     int
+		obs    map[string][]*Observation
+		tracks []*Track
+		expect []string // kind and hex of each finding
+	}{
+		// everything agrees
+		{id: 1, obs: map[string][]*Observation{
+			"AA 0502": {{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"S": edge.River}}},
+			"AA 0503": {{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"N": edge.River}}},
+		}},
+		// a ford on one side of a river is not a contradiction
+		{id: 2, obs: map[string][]*Observation{
+			"AA 0502": {{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"S": edge.River}}},
+			"AA 0503": {{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"N": edge.RiverFord}}},
+		}, tracks: []*Track{{Turn: "900-02", Unit: "0138", Hexes: []string{"AA 0502", "AA 0503"}}}},
+		{id: 3, obs: map[string][]*Observation{
+			"AA 0502": {{Turn: "900-01", Terrain: terrain.PR}, {Turn: "900-02", Terrain: terrain.GH}},
+		}, expect: []string{"terrain-conflict AA 0502"}},
+		{id: 4, obs: map[string][]*Observation{
+			"AA 0502": {{Turn: "900-01", Edges: map[string]edge.Edge{"S": edge.River}}, {Turn: "900-02", Edges: map[string]edge.Edge{"S": edge.OceanCoast}}},
+		}, expect: []string{"edge-conflict AA 0502"}},
+		// 0503 is south east of 0402
+		{id: 5, obs: map[string][]*Observation{
+			"AA 0402": {{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"SE": edge.OceanCoast}}},
+			"AA 0503": {{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"NW": edge.River}}},
+		}, expect: []string{"neighbor-conflict AA 0402"}},
+		{id: 6, obs: map[string][]*Observation{
+			"AA 0502": {{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"S": edge.River}}},
+			"AA 0503": {{Turn: "900-01", Terrain: terrain.PR}},
+		}, tracks: []*Track{{Turn: "900-02", Unit: "0138", Scout: "1", Hexes: []string{"AA 0501", "AA 0502", "AA 0503"}}}, expect: []string{"river-crossing AA 0502"}},
+		// a ford seen from both sides, after one side first reported the river
+		{id: 7, obs: map[string][]*Observation{
+			"AA 0502": {{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"S": edge.River}}, {Turn: "900-02", Edges: map[string]edge.Edge{"S": edge.RiverFord}}},
+			"AA 0503": {{Turn: "900-02", Terrain: terrain.PR, Edges: map[string]edge.Edge{"N": edge.RiverFord}}},
+		}, tracks: []*Track{{Turn: "900-02", Unit: "0138", Hexes: []string{"AA 0502", "AA 0503"}}}},
+		// a ford in one hex doesn't hide a real contradiction
+		{id: 8, obs: map[string][]*Observation{
+			"AA 0502": {{Turn: "900-01", Edges: map[string]edge.Edge{"S": edge.River}}, {Turn: "900-02", Edges: map[string]edge.Edge{"S": edge.RiverFord}}, {Turn: "900-03", Edges: map[string]edge.Edge{"S": edge.OceanCoast}}},
+		}, expect: []string{"edge-conflict AA 0502"}},
+	} {
+		m := New("AA")
+		for id, list := range tc.obs {
+			for _, o := range list {
+				m.Observe(id, o)
+			}
+		}
+		findings := append(m.Check(), m.CheckTracks(tc.tracks)...)
+		SortFindings(findings)
+		if len(findings) != len(tc.expect) {
+			t.Errorf("%d: findings: expected %d: got %d %v\n", tc.id, len(tc.expect), len(findings), findings)
+			continue
+		}
+		for n, f := range findings {
+			if got := f.Kind + " " + f.Hex; got != tc.expect[n] {
+				t.Errorf("%d: finding %d: expected %q: got %q\n", tc.id, n+1, tc.expect[n], got)
+			}
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mdhender/chief/internal/coords"
+	"math"
+	"sort"
+)
+
+// overviewRadius is the size of the hexes on the overview.
+const overviewRadius = 2
+
+// gridSheet is the border and label of one grid on the map.
+type gridSheet struct {
+	name   string  // like "AB"
+	sides  []point // pairs of points, one pair for each side on the border
+	center point
+	size   float64 // font size of the label
+}
+
+// NewOverview returns a zoomed out map for seeing every grid at once.
+// The hexes are small, so the coordinates and edges aren't drawn.
+// Use ShowGrids to add the grid borders and labels.
+func NewOverview() *SVG {
+	s := NewSVG(false)
+	s.overview = true
+	s.width, s.height = 2.0*overviewRadius, math.Sqrt(3.0)*overviewRadius
+	s.layout = NewLayout(overviewRadius)
+	return s
+}
+
+// ShowGrids draws the border and the name ("AA".."ZZ") of every grid
+// that has a tile on the map, and extends the map to show the whole grid.
+// Call it after the tiles have been added.
+func (s *SVG) ShowGrids() {
+	seen := map[string]bool{}
+	var names []string
+	for _, poly := range s.polygons {
+		if name := poly.tile.Id()[:2]; !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	s.grids = nil
+	for _, name := range names {
+		g := &gridSheet{name: name}
+		for row := 1; row <= coords.Rows; row++ {
+			for col := 1; col <= coords.Columns; col++ {
+				if row != 1 && row != coords.Rows && col != 1 && col != coords.Columns {
+					continue
+				}
+				h := hexOf(fmt.Sprintf("%s %02d%02d", name, col, row))
+				corners := s.layout.polygonCorners(h)
+				for d := N; d <= NW; d++ {
+					if id, ok := gxyOf(h.Neighbor(d)); ok && id[:2] == name {
+						continue
+					}
+					g.sides = append(g.sides, corners[sideCorners[d][0]], corners[sideCorners[d][1]])
+				}
+				for _, p := range corners {
+					s.grow(p.x, p.y)
+				}
+			}
+		}
+		topLeft := s.layout.centerPoint(hexOf(name + " 0101"))
+		bottomRight := s.layout.centerPoint(hexOf(fmt.Sprintf("%s %02d%02d", name, coords.Columns, coords.Rows)))
+		g.center = point{x: (topLeft.x + bottomRight.x) / 2, y: (topLeft.y + bottomRight.y) / 2}
+		g.size = (bottomRight.x - topLeft.x) / 4
+		s.grids = append(s.grids, g)
+	}
+}
+
+// gridBorderWidth is the width of the grid borders, in proportion to the hexes.
+func (s *SVG) gridBorderWidth() float64 {
+	return s.height / 6
+}
+
+// gridsBytes returns the borders and labels of the grids.
+func (s *SVG) gridsBytes() []byte {
+	if len(s.grids) == 0 {
+		return nil
+	}
+	buf := bytes.Buffer{}
+	buf.WriteString(fmt.Sprintf(`<g class="grids" stroke="black" stroke-width="%f" stroke-linecap="round">`, s.gridBorderWidth()))
+	buf.WriteByte('\n')
+	for _, g := range s.grids {
+		buf.WriteString(fmt.Sprintf(`<path class="grid-%s" fill="none" d="`, g.name))
+		for i := 0; i+1 < len(g.sides); i += 2 {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			buf.WriteString(fmt.Sprintf("M%f,%f L%f,%f", g.sides[i].x, g.sides[i].y, g.sides[i+1].x, g.sides[i+1].y))
+		}
+		buf.WriteString(`" />`)
+		buf.WriteByte('\n')
+		buf.WriteString(fmt.Sprintf(`<text x="%f" y="%f" text-anchor="middle" dominant-baseline="middle" fill="black" fill-opacity="0.25" stroke="none" font-size="%f" font-weight="bold">%s</text>`, g.center.x, g.center.y, g.size, g.name))
+		buf.WriteByte('\n')
+	}
+	buf.WriteString(`</g>`)
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
@@ -0,0 +1,56 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/terrain"
+	"testing"
+)
+
+func TestShowGrids(t *testing.T) {
+	for _, tc := range []struct {
+		id       int
+		overview bool
+	}{
+		{1, false},
+		{2, true},
+	} {
+		m := New("AA")
+		m.Observe("AB 0505", &Observation{Turn: "900-01", Terrain: terrain.PR})
+		m.Observe("AA 3010", &Observation{Turn: "900-01", Terrain: terrain.PR})
+		s := NewSVG(false)
+		if tc.overview {
+			s = NewOverview()
+		}
+		for _, tile := range m.Tiles() {
+			s.AddTile(tile)
+		}
+		s.ShowGrids()
+		if len(s.grids) != 2 || s.grids[0].name != "AA" || s.grids[1].name != "AB" {
+			t.Fatalf("%d: expected grids AA and AB: got %d\n", tc.id, len(s.grids))
+		}
+
+		for _, g := range s.grids {
+			// the border is closed: every corner on it starts one side and ends another
+			corners := map[string]int{}
+			for _, p := range g.sides {
+				corners[fmt.Sprintf("%.1f,%.1f", p.x, p.y)]++
+			}
+			for key, n := range corners {
+				if n != 2 {
+					t.Errorf("%d: %s: corner %s: expected 2 sides: got %d\n", tc.id, g.name, key, n)
+				}
+			}
+
+			// the whole grid is on the map, not just the tiles in it
+			for _, id := range []string{g.name + " 0101", g.name + " 3021"} {
+				c := s.layout.centerPoint(hexOf(id))
+				if c.x < float64(s.viewBox.minX) || c.x > float64(s.viewBox.maxX) || c.y < float64(s.viewBox.minY) || c.y > float64(s.viewBox.maxY) {
+					t.Errorf("%d: %s: expected %s on the map\n", tc.id, g.name, id)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"testing"
+)
+
+func TestHexOf(t *testing.T) {
+	for _, tc := range []struct {
+		id  int
+		gxy string
+	}{
+		{1, "AA 0101"},
+		{2, "AA 3021"},
+		{3, "AB 0101"},
+		{4, "BA 0101"},
+		{5, "DA 1510"},
+		{6, "ZZ 3021"},
+	} {
+		if got, ok := gxyOf(hexOf(tc.gxy)); !ok || got != tc.gxy {
+			t.Errorf("%d: expected %q: got %q\n", tc.id, tc.gxy, got)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+)
+
+// HTML returns a standalone web page with the map. The page has no external
+// dependencies. Drag the map to pan and use the mouse wheel to zoom.
+func (s *SVG) HTML(title string) []byte {
+	// the page can't rely on the style sheet being next to it
+	img := bytes.Replace(s.Bytes(), []byte("<style>@import url(medoly.css);</style>\n"), nil, 1)
+
+	buf := bytes.Buffer{}
+	buf.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	buf.WriteString(fmt.Sprintf("<title>%s</title>\n", html.EscapeString(title)))
+	buf.WriteString(`<style>
+html, body { margin: 0; height: 100%; overflow: hidden; background: white; }
+svg { width: 100%; height: 100%; cursor: grab; }
+svg.dragging { cursor: grabbing; }
+</style>
+</head>
+<body>
+`)
+	buf.Write(img)
+	buf.WriteString(`
+<script>
+(function () {
+	const svg = document.querySelector("svg");
+	const vb = svg.getAttribute("viewBox").split(" ").map(Number);
+	const update = () => svg.setAttribute("viewBox", vb.join(" "));
+	// toMap converts a point on the screen to a point in the view box.
+	const toMap = (e) => {
+		const p = svg.createSVGPoint();
+		p.x = e.clientX;
+		p.y = e.clientY;
+		return p.matrixTransform(svg.getScreenCTM().inverse());
+	};
+	// drag is the point on the map that stays under the pointer
+	let drag = null;
+	svg.addEventListener("pointerdown", (e) => {
+		drag = toMap(e);
+		svg.classList.add("dragging");
+		svg.setPointerCapture(e.pointerId);
+	});
+	svg.addEventListener("pointermove", (e) => {
+		if (!drag) return;
+		const p = toMap(e);
+		vb[0] -= p.x - drag.x;
+		vb[1] -= p.y - drag.y;
+		update();
+	});
+	svg.addEventListener("pointerup", () => {
+		drag = null;
+		svg.classList.remove("dragging");
+	});
+	svg.addEventListener("wheel", (e) => {
+		e.preventDefault();
+		const { x, y } = toMap(e);
+		const zoom = e.deltaY < 0 ? 0.8 : 1.25;
+		vb[0] = x - (x - vb[0]) * zoom;
+		vb[1] = y - (y - vb[1]) * zoom;
+		vb[2] *= zoom;
+		vb[3] *= zoom;
+		update();
+	}, { passive: false });
+})();
+</script>
+</body>
+</html>
+`)
+	return buf.Bytes()
+}
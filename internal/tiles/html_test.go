@@ -0,0 +1,50 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"bytes"
+	"github.com/mdhender/chief/internal/terrain"
+	"regexp"
+	"testing"
+)
+
+func TestHTML(t *testing.T) {
+	m := New("AA")
+	m.Observe("AA 0505", &Observation{Turn: "900-01", Terrain: terrain.PR})
+	s := NewSVG(true)
+	for _, tile := range m.Tiles() {
+		s.AddTile(tile)
+	}
+	page := s.HTML("Clan <0138>")
+
+	if !bytes.HasPrefix(page, []byte("<!DOCTYPE html>\n")) {
+		t.Errorf("expected a web page: got %q\n", page[:min(len(page), 40)])
+	}
+	if !bytes.Contains(page, []byte("<title>Clan &lt;0138&gt;</title>")) {
+		t.Errorf("expected the title to be escaped\n")
+	}
+
+	// the map is embedded, without the link to the style sheet
+	img := bytes.Replace(s.Bytes(), []byte("<style>@import url(medoly.css);</style>\n"), nil, 1)
+	if !bytes.Contains(page, img) {
+		t.Errorf("expected the svg to be embedded\n")
+	}
+	if n := bytes.Count(page, []byte("<svg")); n != 1 {
+		t.Errorf("expected 1 svg: got %d\n", n)
+	}
+
+	// nothing is loaded from anywhere else
+	for _, re := range []*regexp.Regexp{
+		regexp.MustCompile(`@import`),
+		regexp.MustCompile(`<link\b`),
+		regexp.MustCompile(`<script[^>]+src=`),
+		regexp.MustCompile(`<img\b`),
+		regexp.MustCompile(`url\(`),
+	} {
+		if loc := re.FindIndex(page); loc != nil {
+			t.Errorf("expected a self-contained page: found %q\n", page[loc[0]:loc[1]])
+		}
+	}
+}
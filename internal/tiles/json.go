@@ -0,0 +1,95 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/terrain"
+)
+
+// jsonMap is the JSON form of the map.
+type jsonMap struct {
+	Hash  string      `json:"hash,omitempty"`
+	Tiles []*jsonTile `json:"tiles"`
+}
+
+// jsonTile is the JSON form of a tile. Edges is a map of
+// direction to edge and never includes unknown edges.
+// Inferred is the directions of the edges reported by the neighbors.
+type jsonTile struct {
+	Id           string               `json:"id"`
+	Terrain      terrain.Terrain      `json:"terrain,omitempty"`
+	Edges        map[string]edge.Edge `json:"edges,omitempty"`
+	Inferred     []string             `json:"inferred,omitempty"`
+	Observations []*Observation       `json:"observations,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (m *Map) MarshalJSON() ([]byte, error) {
+	jm := jsonMap{Hash: m.grid.hash, Tiles: []*jsonTile{}}
+	for _, t := range m.Tiles() {
+		jt := &jsonTile{Id: t.id, Terrain: t.Terrain, Observations: t.Observations}
+		for d, e := range t.Edges {
+			if e == edge.Unknown {
+				continue
+			} else if jt.Edges == nil {
+				jt.Edges = make(map[string]edge.Edge)
+			}
+			jt.Edges[Direction(d).String()] = e
+			if t.Inferred[d] {
+				jt.Inferred = append(jt.Inferred, Direction(d).String())
+			}
+		}
+		jm.Tiles = append(jm.Tiles, jt)
+	}
+	return json.Marshal(jm)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (m *Map) UnmarshalJSON(data []byte) error {
+	var jm jsonMap
+	if err := json.Unmarshal(data, &jm); err != nil {
+		return err
+	}
+	*m = *New(jm.Hash)
+	for _, jt := range jm.Tiles {
+		if h, err := model.ParseHex(jt.Id); err != nil {
+			return fmt.Errorf("tile: %w", err)
+		} else if !h.HasGrid() {
+			return fmt.Errorf("tile %q: missing grid", jt.Id)
+		} else if _, ok := m.tiles[jt.Id]; ok {
+			return fmt.Errorf("tile %q: duplicate tile", jt.Id)
+		}
+		t := m.MakeTile(jt.Id)
+		t.Terrain, t.Observations = jt.Terrain, jt.Observations
+		for code, e := range jt.Edges {
+			d, ok := LookupDirection(code)
+			if !ok {
+				return fmt.Errorf("tile %q: unknown direction %q", jt.Id, code)
+			}
+			t.Edges[d] = e
+		}
+		for _, code := range jt.Inferred {
+			d, ok := LookupDirection(code)
+			if !ok {
+				return fmt.Errorf("tile %q: unknown direction %q", jt.Id, code)
+			}
+			t.Inferred[d] = true
+		}
+		m.tiles[jt.Id] = t
+	}
+
+	// maps saved before edges were inferred only have the observed sides
+	for _, t := range m.Tiles() {
+		for d := N; d <= NW; d++ {
+			if !t.Inferred[d] {
+				_ = m.inferEdge(t, d)
+			}
+		}
+	}
+	return nil
+}
@@ -3,7 +3,10 @@
 
 package tiles
 
-import "math"
+import (
+	"github.com/mdhender/chief/internal/coords"
+	"math"
+)
 
 // Layout is a collection of hexes.
 // We are going to use flat top hex in an "even-q" vertical layout.
@@ -75,11 +78,11 @@ var (
 )
 
 // centerPoint returns the center point of the hex on the screen.
-func (l Layout) centerPoint(hex Hex) point {
+func (l Layout) centerPoint(hex coords.Cube) point {
 	M := l.Orientation
 
-	x := (M.f0*float64(hex.q) + M.f1*float64(hex.r)) * l.Width
-	y := (M.f2*float64(hex.q) + M.f3*float64(hex.r)) * l.Height
+	x := (M.f0*float64(hex.Q) + M.f1*float64(hex.R)) * l.Width
+	y := (M.f2*float64(hex.Q) + M.f3*float64(hex.R)) * l.Height
 
 	return point{l.Origin.x + x, l.Origin.y + y}
 }
@@ -93,17 +96,17 @@ func (l Layout) hexCornerOffset(corner int) point {
 	return point{x: l.Width * math.Cos(angle), y: l.Height * math.Sin(angle)}
 }
 
-func (l Layout) hexToPoint(hex Hex) point {
+func (l Layout) hexToPoint(hex coords.Cube) point {
 	return point{
-		x: l.Size * (float64(hex.q) * qBasisVector.x /* + float64(hex.r) * rBasisVector.x */),
-		y: l.Size * (float64(hex.q)*qBasisVector.y + float64(hex.r)*rBasisVector.y),
+		x: l.Size * (float64(hex.Q) * qBasisVector.x /* + float64(hex.R) * rBasisVector.x */),
+		y: l.Size * (float64(hex.Q)*qBasisVector.y + float64(hex.R)*rBasisVector.y),
 	}
 }
 
 // polygonCorners returns the screen coordinates for all the corners of the hex.
 // It uses the layout to determine the orientation of the hex and the center point
 // of it on the screen.
-func (l Layout) polygonCorners(h Hex) (corners []point) {
+func (l Layout) polygonCorners(h coords.Cube) (corners []point) {
 	center := l.centerPoint(h)
 	for i := 0; i < 6; i++ {
 		offset := l.hexCornerOffset(i)
@@ -3,7 +3,12 @@
 
 package tiles
 
-import "sort"
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/coords"
+	"github.com/mdhender/chief/internal/edge"
+	"sort"
+)
 
 type Map struct {
 	tiles map[string]*Tile
@@ -17,24 +22,94 @@ type Map struct {
 func New(hash string) *Map {
 	m := &Map{tiles: make(map[string]*Tile)}
 	m.grid.hash = hash
-	m.grid.columns, m.grid.rows = 30, 21
+	m.grid.columns, m.grid.rows = coords.Columns, coords.Rows
 	return m
 }
 
+// Hash returns the grid that "##" hexes are placed in.
+func (m *Map) Hash() string {
+	return m.grid.hash
+}
+
 // MakeTile accepts TribeNet's ## XXYY coordinates.
 // It returns a Tile using the internal coordinate system.
 func (m *Map) MakeTile(gxy string) *Tile {
-	x, y := gxyScale(gxy)
 	t := &Tile{
-		Hex: RowColToHex(y, x),
+		Hex: hexOf(gxy),
 		id:  gxy,
 	}
 	return t
 }
 
+// Lookup returns the tile for TribeNet's "AB 0102" coordinates, or nil if
+// the map doesn't have it. Hexes in the "##" grid use the map's hash grid.
+// Unlike Neighbor, it never adds a tile to the map.
+func (m *Map) Lookup(gxy string) *Tile {
+	if len(gxy) == 7 && gxy[:2] == "##" {
+		gxy = m.grid.hash + gxy[2:]
+	}
+	return m.tiles[gxy]
+}
+
+// SetEdge records the edge seen on a side of the hex, creating the tile
+// if needed. The neighbor shares the side, so if the neighbor is on the
+// map, the edge is also set on its opposite side and marked as inferred.
+// An edge the neighbor observed itself is never replaced by an inferred one.
+//
+// It returns an error if the neighbor observed a different edge on the
+// side. A river seen from one side and a ford seen from the other is not
+// a conflict, since fords aren't always reported from both sides.
+func (m *Map) SetEdge(gxy string, d Direction, e edge.Edge) error {
+	t := m.addTile(gxy)
+	t.Edges[d], t.Inferred[d] = e, false
+	return m.inferEdge(t, d)
+}
+
+// addTile returns the tile for the hex, creating it if needed.
+// A new tile picks up the edges its neighbors observed on the sides
+// they share with it.
+func (m *Map) addTile(gxy string) *Tile {
+	if len(gxy) == 7 && gxy[:2] == "##" {
+		gxy = m.grid.hash + gxy[2:]
+	}
+	if t, ok := m.tiles[gxy]; ok {
+		return t
+	}
+	t := m.MakeTile(gxy)
+	m.tiles[gxy] = t
+	for d := N; d <= NW; d++ {
+		if n := m.lookupNeighbor(t, d); n != nil && !n.Inferred[d.Add(3)] && n.Edges[d.Add(3)] != edge.Unknown {
+			t.Edges[d], t.Inferred[d] = n.Edges[d.Add(3)], true
+		}
+	}
+	return t
+}
+
+// inferEdge copies the edge on a side of the tile to the neighbor's
+// opposite side, unless the neighbor isn't on the map or observed
+// that side itself.
+func (m *Map) inferEdge(t *Tile, d Direction) error {
+	e, n := t.Edges[d], m.lookupNeighbor(t, d)
+	if e == edge.Unknown || n == nil {
+		return nil
+	}
+	opposite := d.Add(3)
+	if ne := n.Edges[opposite]; ne == edge.Unknown || n.Inferred[opposite] {
+		n.Edges[opposite], n.Inferred[opposite] = e, true
+	} else if !edgesAgree(e, ne) {
+		return fmt.Errorf("%s %s: %s: %s reports %s", t.id, d, e.Description(), n.id, ne.Description())
+	}
+	return nil
+}
+
+// Neighbor returns the tile next to the tile in the direction, adding
+// it to the map if needed. It returns nil if the neighbor is off the map.
 func (m *Map) Neighbor(from *Tile, d Direction) *Tile {
-	hex := from.Neighbor(d)
-	id := xlatToGXY(hex.ToXY())
+	hex := from.Hex.Neighbor(d)
+	id, ok := gxyOf(hex)
+	if !ok {
+		return nil
+	}
 	tt, ok := m.tiles[id]
 	if !ok {
 		tt = &Tile{Hex: hex, id: id}
@@ -0,0 +1,114 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"encoding/json"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/terrain"
+	"testing"
+)
+
+func TestSetEdge(t *testing.T) {
+	// 0503 is south of 0502 and south east of 0402
+	m := New("AA")
+	m.Observe("AA 0502", &Observation{Turn: "900-01", Terrain: terrain.PR})
+	m.Observe("AA 0402", &Observation{Turn: "900-01", Terrain: terrain.PR})
+
+	// the river is seen from 0503 before 0502 reports anything on that side
+	if err := m.SetEdge("AA 0503", N, edge.River); err != nil {
+		t.Errorf("1: expected no conflict: got %v\n", err)
+	}
+	if got := m.Lookup("AA 0502"); got.Edges[S] != edge.River || !got.Inferred[S] {
+		t.Errorf("1: 0502 S: expected inferred river: got %s %v\n", got.Edges[S], got.Inferred[S])
+	}
+	if got := m.Lookup("AA 0503"); got.Edges[N] != edge.River || got.Inferred[N] {
+		t.Errorf("1: 0503 N: expected observed river: got %s %v\n", got.Edges[N], got.Inferred[N])
+	}
+
+	// an observation replaces the inferred edge and updates the other side
+	m.Observe("AA 0502", &Observation{Turn: "900-02", Edges: map[string]edge.Edge{"S": edge.RiverFord}})
+	if got := m.Lookup("AA 0502"); got.Edges[S] != edge.RiverFord || got.Inferred[S] {
+		t.Errorf("2: 0502 S: expected observed ford: got %s %v\n", got.Edges[S], got.Inferred[S])
+	}
+	if got := m.Lookup("AA 0503"); got.Edges[N] != edge.River {
+		t.Errorf("2: 0503 N: expected observed river to be kept: got %s\n", got.Edges[N])
+	}
+
+	// a different edge observed on the other side is a conflict and is kept
+	m.Observe("AA 0402", &Observation{Turn: "900-01", Edges: map[string]edge.Edge{"SE": edge.OceanCoast}})
+	if err := m.SetEdge("AA 0503", NW, edge.River); err == nil {
+		t.Errorf("3: expected conflict: got nil\n")
+	}
+	if got := m.Lookup("AA 0402"); got.Edges[SE] != edge.OceanCoast || got.Inferred[SE] {
+		t.Errorf("3: 0402 SE: expected observed coast: got %s %v\n", got.Edges[SE], got.Inferred[SE])
+	}
+
+	// a new tile picks up the edges its neighbors observed
+	if err := m.SetEdge("AA 0801", S, edge.River); err != nil {
+		t.Errorf("4: expected no conflict: got %v\n", err)
+	}
+	m.Observe("AA 0802", &Observation{Turn: "900-02", Terrain: terrain.PR})
+	if got := m.Lookup("AA 0802"); got.Edges[N] != edge.River || !got.Inferred[N] {
+		t.Errorf("4: 0802 N: expected inferred river: got %s %v\n", got.Edges[N], got.Inferred[N])
+	}
+
+	// the inferred edges survive saving the map
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("5: marshal: %v\n", err)
+	}
+	mm := &Map{}
+	if err := json.Unmarshal(data, mm); err != nil {
+		t.Fatalf("5: unmarshal: %v\n", err)
+	}
+	for _, tile := range m.Tiles() {
+		if got := mm.Lookup(tile.Id()); got == nil || got.Edges != tile.Edges || got.Inferred != tile.Inferred {
+			t.Errorf("5: %s: expected %v %v: got %v\n", tile.Id(), tile.Edges, tile.Inferred, got)
+		}
+	}
+
+	// Observe doesn't return the conflict from SetEdge, so Check reports it
+	m = New("AA")
+	m.Observe("AA 0402", &Observation{Turn: "900-01", Terrain: terrain.PR, Edges: map[string]edge.Edge{"SE": edge.OceanCoast}})
+	m.Observe("AA 0503", &Observation{Turn: "900-02", Terrain: terrain.PR, Edges: map[string]edge.Edge{"NW": edge.River}})
+	if got := m.Lookup("AA 0402"); got.Edges[SE] != edge.OceanCoast || got.Inferred[SE] {
+		t.Errorf("6: 0402 SE: expected observed coast: got %s %v\n", got.Edges[SE], got.Inferred[SE])
+	}
+	if got := m.Lookup("AA 0503"); got.Edges[NW] != edge.River || got.Inferred[NW] {
+		t.Errorf("6: 0503 NW: expected observed river: got %s %v\n", got.Edges[NW], got.Inferred[NW])
+	}
+	if findings := m.Check(); len(findings) != 1 {
+		t.Errorf("6: expected 1 finding: got %v\n", findings)
+	} else if f := findings[0]; f.Kind != NeighborConflict || f.Hex != "AA 0402" {
+		t.Errorf("6: expected neighbor conflict in AA 0402: got %v\n", f)
+	}
+}
+
+func TestNeighbor(t *testing.T) {
+	m := New("AA")
+	from := m.Observe("AA 0101", &Observation{Turn: "900-01", Terrain: terrain.PR})
+	for _, tc := range []struct {
+		id     int
+		d      Direction
+		expect string // empty if off the map
+	}{
+		{1, N, ""},
+		{2, NW, ""},
+		{3, S, "AA 0102"},
+		{4, NE, ""},
+		{5, SE, "AA 0201"},
+	} {
+		got := m.Neighbor(from, tc.d)
+		if tc.expect == "" && got != nil {
+			t.Errorf("%d: expected nil: got %q\n", tc.id, got.Id())
+		} else if tc.expect != "" && (got == nil || got.Id() != tc.expect) {
+			t.Errorf("%d: expected %q: got %v\n", tc.id, tc.expect, got)
+		}
+	}
+	// the neighbors on the map are added, the ones off it aren't
+	if got := len(m.Tiles()); got != 3 {
+		t.Errorf("expected 3 tiles: got %d\n", got)
+	}
+}
@@ -0,0 +1,175 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/terrain"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+// Observation is what one unit reported about a hex on one turn.
+// Observations are never overwritten, so the tile keeps a history
+// of everything reported about the hex.
+type Observation struct {
+	// Turn is empty for observations that aren't from a turn report,
+	// so they sort before (and are replaced by) the reports.
+	Turn string `json:"turn"`
+	// Source is where the observation came from if it isn't
+	// a turn report, like a Worldographer file.
+	Source string `json:"source,omitempty"`
+	Clan   string `json:"clan,omitempty"`
+	Unit   string `json:"unit,omitempty"`
+	// UnitInferred is set when the report didn't name the unit,
+	// so Unit is a guess.
+	UnitInferred bool `json:"unit-inferred,omitempty"`
+	// Terrain is Unknown if the unit didn't enter the hex.
+	Terrain terrain.Terrain `json:"terrain,omitempty"`
+	// Edges is a map of direction (e.g. "SE") to the edge seen.
+	Edges map[string]edge.Edge `json:"edges,omitempty"`
+	// Found is a list of resources and other things found in the hex.
+	Found []string `json:"found,omitempty"`
+	// Settlements is a list of settlement names seen in the hex.
+	Settlements []string `json:"settlements,omitempty"`
+	// Unresolved is set when the report hid the grid and it couldn't be
+	// resolved, so the hex was placed in the map's hash grid.
+	Unresolved bool `json:"unresolved,omitempty"`
+}
+
+// Observe adds an observation to the tile for the hex, creating the tile
+// if needed. Hexes in the "##" grid are placed in the map's hash grid.
+//
+// The tile's terrain and edges are updated from the most recent turn that
+// reported them, and the edges are inferred on the neighbors' sides.
+// Observations that disagree are kept side by side; use Conflicts to find
+// them, and Check to find the sides that the neighbors see differently.
+// Adding the same observation twice has no effect.
+//
+// The tile keeps a copy of the observation, so the caller may reuse it.
+func (m *Map) Observe(gxy string, o *Observation) *Tile {
+	t := m.addTile(gxy)
+	o = o.clone()
+	for _, seen := range t.Observations {
+		if reflect.DeepEqual(seen, o) {
+			return t
+		}
+	}
+	t.Observations = append(t.Observations, o)
+	sort.SliceStable(t.Observations, func(i, j int) bool {
+		return t.Observations[i].Turn < t.Observations[j].Turn
+	})
+
+	// later observations replace earlier ones
+	var edges [6]edge.Edge
+	t.Terrain = terrain.Unknown
+	for _, o := range t.Observations {
+		if o.Terrain != terrain.Unknown {
+			t.Terrain = o.Terrain
+		}
+		for code, e := range o.Edges {
+			if d, ok := LookupDirection(code); ok {
+				edges[d] = e
+			}
+		}
+	}
+	for d, e := range edges {
+		if e != edge.Unknown {
+			// Check reports the sides the neighbors see differently
+			_ = m.SetEdge(t.id, Direction(d), e)
+		}
+	}
+
+	return t
+}
+
+// clone returns a copy of the observation without the unknown edges.
+func (o *Observation) clone() *Observation {
+	c := *o
+	c.Edges = nil
+	for code, e := range o.Edges {
+		if e == edge.Unknown {
+			continue
+		} else if c.Edges == nil {
+			c.Edges = make(map[string]edge.Edge)
+		}
+		c.Edges[code] = e
+	}
+	c.Found = slices.Clone(o.Found)
+	c.Settlements = slices.Clone(o.Settlements)
+	return &c
+}
+
+// Conflict is a hex that units reported differently.
+type Conflict struct {
+	Tile *Tile
+	// Terrain is the different terrains reported.
+	Terrain []terrain.Terrain
+	// Edges is a map of direction to the different edges reported.
+	Edges map[Direction][]edge.Edge
+}
+
+// Conflicts returns the tiles with observations that disagree,
+// sorted by tile id.
+func (m *Map) Conflicts() []*Conflict {
+	var list []*Conflict
+	for _, t := range m.Tiles() {
+		if c := t.Conflicts(); c != nil {
+			list = append(list, c)
+		}
+	}
+	return list
+}
+
+// Conflicts returns the terrain and edges that were reported differently,
+// or nil if all the observations of the tile agree.
+func (t *Tile) Conflicts() *Conflict {
+	var terrains []terrain.Terrain
+	edges := map[Direction][]edge.Edge{}
+	for _, o := range t.Observations {
+		if o.Terrain != terrain.Unknown && !slices.Contains(terrains, o.Terrain) {
+			terrains = append(terrains, o.Terrain)
+		}
+		for code, e := range o.Edges {
+			if d, ok := LookupDirection(code); ok && !slices.Contains(edges[d], e) {
+				edges[d] = append(edges[d], e)
+			}
+		}
+	}
+
+	c := &Conflict{Tile: t, Edges: map[Direction][]edge.Edge{}}
+	if len(terrains) > 1 {
+		c.Terrain = terrains
+	}
+	for d, list := range edges {
+		if len(list) > 1 {
+			c.Edges[d] = list
+		}
+	}
+	if c.Terrain == nil && len(c.Edges) == 0 {
+		return nil
+	}
+	return c
+}
+
+// Unresolved returns true if any observation placed the tile
+// in the hash grid because the real grid wasn't known.
+func (t *Tile) Unresolved() bool {
+	for _, o := range t.Observations {
+		if o.Unresolved {
+			return true
+		}
+	}
+	return false
+}
+
+// LastSeen returns the most recent turn the tile was observed,
+// or an empty string if it never was.
+func (t *Tile) LastSeen() string {
+	if len(t.Observations) == 0 {
+		return ""
+	}
+	return t.Observations[len(t.Observations)-1].Turn
+}
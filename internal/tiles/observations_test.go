@@ -0,0 +1,78 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"encoding/json"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/terrain"
+	"testing"
+)
+
+func TestObserve(t *testing.T) {
+	m := New("DA")
+	m.Observe("## 0102", &Observation{Turn: "900-02", Unit: "0138", Terrain: terrain.GH})
+	m.Observe("DA 0102", &Observation{Turn: "900-01", Unit: "0138e1", Terrain: terrain.PR, Edges: map[string]edge.Edge{"SE": edge.River}})
+	tile := m.Observe("DA 0102", &Observation{Turn: "900-02", Unit: "0138", Terrain: terrain.GH})
+
+	if got := len(m.Tiles()); got != 1 {
+		t.Fatalf("tiles: want 1, got %d\n", got)
+	} else if got := len(tile.Observations); got != 2 {
+		t.Fatalf("observations: want 2, got %d\n", got)
+	} else if tile.Terrain != terrain.GH {
+		t.Errorf("terrain: want %q, got %q\n", terrain.GH, tile.Terrain)
+	} else if tile.Edges[SE] != edge.River {
+		t.Errorf("edge: want %q, got %q\n", edge.River, tile.Edges[SE])
+	} else if tile.LastSeen() != "900-02" {
+		t.Errorf("last seen: want %q, got %q\n", "900-02", tile.LastSeen())
+	}
+	if c := tile.Conflicts(); c == nil || len(c.Terrain) != 2 {
+		t.Errorf("conflicts: want 2 terrains, got %+v\n", c)
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("marshal: %v\n", err)
+	}
+	var got Map
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("unmarshal: %v\n", err)
+	}
+	if tiles := got.Tiles(); len(tiles) != 1 {
+		t.Errorf("unmarshal: want 1 tile, got %d\n", len(tiles))
+	} else if tiles[0].Terrain != terrain.GH || tiles[0].Edges[SE] != edge.River || len(tiles[0].Observations) != 2 {
+		t.Errorf("unmarshal: got %+v\n", tiles[0])
+	}
+}
+
+func TestObserveCopies(t *testing.T) {
+	m := New("DA")
+	o := &Observation{Turn: "900-01", Unit: "0138", Terrain: terrain.PR, Edges: map[string]edge.Edge{"N": edge.Unknown, "SE": edge.River}, Found: []string{"Iron"}}
+	tile := m.Observe("DA 0102", o)
+
+	// the caller's observation isn't changed
+	if len(o.Edges) != 2 {
+		t.Errorf("caller: want 2 edges, got %v\n", o.Edges)
+	}
+	// and changing it doesn't change the tile
+	o.Edges["SE"], o.Found[0], o.Terrain = edge.RiverFord, "Gold", terrain.GH
+	if got := tile.Observations[0]; got == o {
+		t.Errorf("observation: want a copy, got the caller's\n")
+	} else if len(got.Edges) != 1 || got.Edges["SE"] != edge.River {
+		t.Errorf("edges: want SE River, got %v\n", got.Edges)
+	} else if got.Found[0] != "Iron" || got.Terrain != terrain.PR {
+		t.Errorf("observation: want Iron on prairie, got %+v\n", got)
+	}
+
+	// reusing the observation for the next report adds a new one
+	m.Observe("DA 0102", o)
+	if got := len(tile.Observations); got != 2 {
+		t.Errorf("observations: want 2, got %d\n", got)
+	}
+	// and an unknown edge doesn't make the same observation look new
+	m.Observe("DA 0102", &Observation{Turn: "900-01", Unit: "0138", Terrain: terrain.PR, Edges: map[string]edge.Edge{"S": edge.Unknown, "SE": edge.River}, Found: []string{"Iron"}})
+	if got := len(tile.Observations); got != 2 {
+		t.Errorf("duplicate: want 2 observations, got %d\n", got)
+	}
+}
@@ -0,0 +1,201 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"container/heap"
+	"fmt"
+	"github.com/mdhender/chief/internal/coords"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/model"
+	"github.com/mdhender/chief/internal/terrain"
+	"strings"
+)
+
+// PathOptions controls how FindPath moves through the map.
+type PathOptions struct {
+	// Costs is the movement points to enter each terrain, usually from
+	// terrain.Rules.Table. Terrain missing from the table can't be entered.
+	// If nil, the default rules for moving on foot are used.
+	Costs map[terrain.Terrain]int
+	// Crossings is the extra cost to cross edges.
+	// If nil, edge.DefaultCrossings is used.
+	Crossings edge.Crossings
+	// Unknown is set if hexes that haven't been seen can be entered.
+	Unknown bool
+	// UnknownCost is the cost to enter a hex that hasn't been seen.
+	// If zero, it is the highest cost in the table.
+	UnknownCost int
+}
+
+// Path is a route between two hexes.
+type Path struct {
+	// Hexes is every hex on the route, starting hex first.
+	Hexes []string
+	// Directions is the move out of each hex.
+	Directions []Direction
+	// Cost is the movement points to follow the route.
+	Cost int
+}
+
+// Orders returns the directions separated by tabs, so they can be pasted
+// into the movement cells of the orders workbook.
+func (p *Path) Orders() string {
+	var list []string
+	for _, d := range p.Directions {
+		list = append(list, d.String())
+	}
+	return strings.Join(list, "\t")
+}
+
+// FindPath returns the cheapest route between two hexes, like "AB 0102",
+// using the A* search. With the default crossings, rivers without a ford
+// and the ocean coast can't be crossed. It returns an error if there is
+// no route.
+func (m *Map) FindPath(from, to string, opts PathOptions) (*Path, error) {
+	for _, id := range []string{from, to} {
+		if h, err := model.ParseHex(id); err != nil {
+			return nil, fmt.Errorf("path: %w", err)
+		} else if !h.HasGrid() {
+			return nil, fmt.Errorf("path: %q: grid must be known", id)
+		}
+	}
+	costs := opts.Costs
+	if costs == nil {
+		costs = terrain.DefaultRules().Table("", terrain.Foot)
+	}
+	crossings := opts.Crossings
+	if crossings == nil {
+		crossings = edge.DefaultCrossings()
+	}
+	minCost, maxCost := 0, 0
+	for _, c := range costs {
+		if c <= 0 {
+			continue
+		} else if minCost == 0 || c < minCost {
+			minCost = c
+		}
+		maxCost = max(maxCost, c)
+	}
+	unknownCost := 0
+	if opts.Unknown {
+		if unknownCost = opts.UnknownCost; unknownCost <= 0 {
+			unknownCost = maxCost
+		}
+		if minCost == 0 || unknownCost < minCost {
+			minCost = unknownCost
+		}
+	}
+
+	// cost returns the movement points to enter the hex, or false if it can't be entered
+	cost := func(t *Tile) (int, bool) {
+		if t == nil || t.Terrain == terrain.Unknown {
+			return unknownCost, unknownCost > 0
+		}
+		c, ok := costs[t.Terrain]
+		return c, ok && c > 0
+	}
+
+	start, goal := m.MakeTile(from).Hex, m.MakeTile(to).Hex
+	if t, ok := m.tiles[to]; (!ok || t.Terrain == terrain.Unknown) && !opts.Unknown {
+		return nil, fmt.Errorf("path: %s to %s: %s hasn't been seen", from, to, to)
+	} else if _, ok := cost(t); !ok {
+		return nil, fmt.Errorf("path: %s to %s: %s can't be entered", from, to, to)
+	}
+
+	type step struct {
+		from coords.Cube
+		d    Direction
+		cost int
+	}
+	came := map[coords.Cube]step{start: {from: start, cost: 0}}
+	open := &pathQueue{}
+	heap.Push(open, &pathNode{hex: start, priority: start.Distance(goal) * minCost})
+	for open.Len() != 0 {
+		node := heap.Pop(open).(*pathNode)
+		h := node.hex
+		if h == goal {
+			break
+		}
+		here := came[h]
+		if node.priority > here.cost+h.Distance(goal)*minCost {
+			// a cheaper route to this hex was already expanded
+			continue
+		}
+		hid, _ := gxyOf(h)
+		ht := m.tiles[hid]
+		for d := N; d <= NW; d++ {
+			n := h.Neighbor(d)
+			nid, ok := gxyOf(n)
+			if !ok {
+				continue
+			}
+			nt := m.tiles[nid]
+			c, ok := cost(nt)
+			if !ok {
+				continue
+			}
+			extra, ok := crossings.Cost(SideEdges(ht, nt, d)...)
+			if !ok {
+				continue
+			}
+			total := here.cost + c + extra
+			if prev, ok := came[n]; ok && prev.cost <= total {
+				continue
+			}
+			came[n] = step{from: h, d: d, cost: total}
+			heap.Push(open, &pathNode{hex: n, priority: total + n.Distance(goal)*minCost})
+		}
+	}
+
+	end, ok := came[goal]
+	if !ok {
+		return nil, fmt.Errorf("path: %s to %s: no route", from, to)
+	}
+	p := &Path{Cost: end.cost}
+	for h := goal; h != start; h = came[h].from {
+		id, _ := gxyOf(h)
+		p.Hexes = append([]string{id}, p.Hexes...)
+		p.Directions = append([]Direction{came[h].d}, p.Directions...)
+	}
+	p.Hexes = append([]string{from}, p.Hexes...)
+	return p, nil
+}
+
+// SideEdges returns the edges reported on both sides of the side between
+// the tiles, for Crossings.Cost. Either tile may be nil.
+func SideEdges(from, to *Tile, d Direction) []edge.Edge {
+	var edges []edge.Edge
+	if from != nil {
+		edges = append(edges, from.Edges[d])
+	}
+	if to != nil {
+		edges = append(edges, to.Edges[d.Add(3)])
+	}
+	return edges
+}
+
+// pathNode is a hex waiting to be expanded by the search.
+type pathNode struct {
+	hex      coords.Cube
+	priority int
+}
+
+// pathQueue implements heap.Interface with the lowest priority first.
+type pathQueue []*pathNode
+
+func (q pathQueue) Len() int { return len(q) }
+
+func (q pathQueue) Less(i, j int) bool { return q[i].priority < q[j].priority }
+
+func (q pathQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *pathQueue) Push(x any) { *q = append(*q, x.(*pathNode)) }
+
+func (q *pathQueue) Pop() any {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
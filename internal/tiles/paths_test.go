@@ -0,0 +1,70 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"fmt"
+	"github.com/mdhender/chief/internal/edge"
+	"github.com/mdhender/chief/internal/terrain"
+	"testing"
+)
+
+func TestFindPath(t *testing.T) {
+	// a column of prairie from 0501 down to 0505, with swamp beside it in 0601..0605
+	m := New("AA")
+	for row := 1; row <= 5; row++ {
+		m.Observe(fmt.Sprintf("AA 05%02d", row), &Observation{Turn: "900-01", Terrain: terrain.PR})
+		m.Observe(fmt.Sprintf("AA 06%02d", row), &Observation{Turn: "900-01", Terrain: terrain.SW})
+	}
+
+	// a coast between rows 2 and 3 of the known hexes
+	coast := map[string]map[string]edge.Edge{"AA 0502": {"S": edge.OceanCoast}, "AA 0602": {"S": edge.OceanCoast, "SW": edge.OceanCoast}}
+
+	for _, tc := range []struct {
+		id       int
+		from, to string
+		edges    map[string]map[string]edge.Edge
+		unknown  bool
+		orders   string
+		cost     int
+		err      bool
+	}{
+		{id: 1, from: "AA 0501", to: "AA 0505", orders: "S\tS\tS\tS", cost: 12},
+		{id: 2, from: "AA 0501", to: "AA 0501", orders: "", cost: 0},
+		// a river between 0502 and 0503 sends the route around it through the swamp
+		{id: 3, from: "AA 0501", to: "AA 0505", edges: map[string]map[string]edge.Edge{"AA 0502": {"S": edge.River}}, orders: "S\tSE\tSW\tS\tS", cost: 3 + 8 + 3 + 3 + 3},
+		// unless the other side reports a ford, which costs an extra point to cross
+		{id: 4, from: "AA 0501", to: "AA 0505", edges: map[string]map[string]edge.Edge{"AA 0502": {"S": edge.River}, "AA 0503": {"N": edge.RiverFord}}, orders: "S\tS\tS\tS", cost: 13},
+		// the coast can't be crossed and unknown hexes can't be entered
+		{id: 5, from: "AA 0501", to: "AA 0505", edges: coast, err: true},
+		// unless the caller allows it
+		{id: 6, from: "AA 0501", to: "AA 0505", edges: coast, unknown: true, orders: "S\tSW\tSE\tS\tS", cost: 3 + 12 + 3 + 3 + 3},
+		{id: 7, from: "AA 0501", to: "AA 0905", err: true},
+		{id: 8, from: "## 0501", to: "AA 0505", err: true},
+	} {
+		mm := New("AA")
+		for _, t := range m.Tiles() {
+			mm.Observe(t.Id(), &Observation{Turn: "900-01", Terrain: t.Terrain, Edges: tc.edges[t.Id()]})
+		}
+		p, err := mm.FindPath(tc.from, tc.to, PathOptions{Unknown: tc.unknown})
+		if tc.err {
+			if err == nil {
+				t.Errorf("%d: expected error: got %q\n", tc.id, p.Orders())
+			}
+			continue
+		} else if err != nil {
+			t.Errorf("%d: expected path: got %v\n", tc.id, err)
+			continue
+		}
+		if p.Orders() != tc.orders {
+			t.Errorf("%d: orders: expected %q: got %q\n", tc.id, tc.orders, p.Orders())
+		}
+		if p.Cost != tc.cost {
+			t.Errorf("%d: cost: expected %d: got %d\n", tc.id, tc.cost, p.Cost)
+		}
+		if len(p.Hexes) != len(p.Directions)+1 || p.Hexes[0] != tc.from || p.Hexes[len(p.Hexes)-1] != tc.to {
+			t.Errorf("%d: hexes: got %v\n", tc.id, p.Hexes)
+		}
+	}
+}
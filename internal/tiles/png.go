@@ -0,0 +1,212 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxPixels limits the size of the PNG image.
+const maxPixels = 64 * 1024 * 1024
+
+// PNG writes the map as a PNG image, with scale pixels for each unit of
+// the SVG view box. The hexes, edges and tracks are drawn the same as the
+// SVG, and settlements are drawn as markers. Text (the coordinates, the
+// labels and the legend) is not drawn since the standard library doesn't
+// have fonts.
+func (s *SVG) PNG(w io.Writer, scale float64) error {
+	vb := s.viewBox
+	ox, oy := float64(vb.minX-EDGE/2), float64(vb.minY-EDGE/2)
+	width := int(math.Ceil(float64(vb.maxX-vb.minX+EDGE) * scale))
+	height := int(math.Ceil(float64(vb.maxY-vb.minY+EDGE) * scale))
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("png: empty map")
+	} else if width*height > maxPixels {
+		return fmt.Errorf("png: %d x %d image is too large", width, height)
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+
+	toPixels := func(p point) point {
+		return point{x: (p.x - ox) * scale, y: (p.y - oy) * scale}
+	}
+
+	for _, poly := range s.polygons {
+		var pts []point
+		for _, p := range poly.points {
+			pts = append(pts, toPixels(p))
+		}
+		fillPolygon(img, pts, parseColor(poly.style.fill))
+		stroke := parseColor(poly.style.stroke)
+		for i := range pts {
+			drawLine(img, pts[i], pts[(i+1)%len(pts)], scale, nil, stroke)
+		}
+	}
+
+	if s.ageTurn != "" {
+		for _, poly := range s.polygons {
+			age, ok := poly.tile.Age(s.ageTurn)
+			if opacity := ageOpacity(age, ok); opacity != 0 {
+				var pts []point
+				for _, p := range poly.points {
+					pts = append(pts, toPixels(p))
+				}
+				fillPolygon(img, pts, color.NRGBA{R: 255, G: 255, B: 255, A: uint8(opacity * 255)})
+			}
+		}
+	}
+
+	for _, sd := range s.sides() {
+		style := edgeStyles[sd.edge]
+		var dash []float64
+		for _, f := range strings.Split(style.dash, ",") {
+			if v, err := strconv.ParseFloat(f, 64); err == nil {
+				dash = append(dash, v*scale)
+			}
+		}
+		drawLine(img, toPixels(sd.from), toPixels(sd.to), pixels(style.width)*scale, dash, parseColor(style.stroke))
+	}
+
+	for _, poly := range s.polygons {
+		if poly.settlement != "" {
+			fillCircle(img, toPixels(point{x: poly.cx, y: poly.cy}), poly.radius/2*scale, color.Black)
+			fillCircle(img, toPixels(point{x: poly.cx, y: poly.cy}), poly.radius/3*scale, color.White)
+		}
+	}
+
+	for _, g := range s.grids {
+		for i := 0; i+1 < len(g.sides); i += 2 {
+			drawLine(img, toPixels(g.sides[i]), toPixels(g.sides[i+1]), s.gridBorderWidth()*scale, nil, color.Black)
+		}
+	}
+
+	for _, tr := range s.tracks {
+		var dash []float64
+		if tr.dashed {
+			dash = []float64{3 * scale, 2 * scale}
+		}
+		c := parseColor(tr.color)
+		for i := 1; i < len(tr.points); i++ {
+			drawLine(img, toPixels(tr.points[i-1]), toPixels(tr.points[i]), 1.5*scale, dash, c)
+		}
+		fillCircle(img, toPixels(tr.points[0]), 2*scale, c)
+		fillCircle(img, toPixels(tr.points[0]), 1.5*scale, color.White)
+		if len(tr.points) > 1 {
+			fillCircle(img, toPixels(tr.points[len(tr.points)-1]), 2*scale, c)
+		}
+		for _, p := range tr.stops {
+			fillCircle(img, toPixels(p), 2.5*scale, parseColor("#cc0000"))
+		}
+	}
+
+	return png.Encode(w, img)
+}
+
+// fillPolygon fills the polygon using the even-odd rule,
+// sampling at the center of each pixel. Colors that aren't
+// opaque are blended with the image.
+func fillPolygon(img *image.RGBA, pts []point, c color.Color) {
+	src := &image.Uniform{C: c}
+	_, _, _, alpha := c.RGBA()
+	opaque := alpha == 0xffff
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for _, p := range pts {
+		minY, maxY = math.Min(minY, p.y), math.Max(maxY, p.y)
+	}
+	for y := int(math.Floor(minY)); y <= int(math.Ceil(maxY)); y++ {
+		yc := float64(y) + 0.5
+		var xs []float64
+		for i, a := range pts {
+			b := pts[(i+1)%len(pts)]
+			if (a.y <= yc && yc < b.y) || (b.y <= yc && yc < a.y) {
+				xs = append(xs, a.x+(yc-a.y)*(b.x-a.x)/(b.y-a.y))
+			}
+		}
+		sort.Float64s(xs)
+		for i := 0; i+1 < len(xs); i += 2 {
+			for x := int(math.Ceil(xs[i] - 0.5)); x <= int(math.Floor(xs[i+1]-0.5)); x++ {
+				if opaque {
+					img.Set(x, y, c)
+				} else {
+					draw.Draw(img, image.Rect(x, y, x+1, y+1), src, image.Point{}, draw.Over)
+				}
+			}
+		}
+	}
+}
+
+// drawLine draws a line of the given width. If dash is not empty, it
+// is the lengths of the dashes and gaps, like the stroke-dasharray.
+func drawLine(img *image.RGBA, from, to point, width float64, dash []float64, c color.Color) {
+	length := math.Hypot(to.x-from.x, to.y-from.y)
+	r := math.Max(width/2, 0.5)
+	var period float64
+	for _, d := range dash {
+		period += d
+	}
+	for t := 0.0; t <= length; t += 0.5 {
+		if period > 0 && !dashOn(math.Mod(t, period), dash) {
+			continue
+		}
+		fillCircle(img, point{x: from.x + (to.x-from.x)*t/length, y: from.y + (to.y-from.y)*t/length}, r, c)
+	}
+}
+
+// fillCircle fills the circle with center c and radius r.
+func fillCircle(img *image.RGBA, c point, r float64, clr color.Color) {
+	for y := int(math.Floor(c.y - r)); y <= int(math.Ceil(c.y+r)); y++ {
+		for x := int(math.Floor(c.x - r)); x <= int(math.Ceil(c.x+r)); x++ {
+			if math.Hypot(float64(x)+0.5-c.x, float64(y)+0.5-c.y) <= r {
+				img.Set(x, y, clr)
+			}
+		}
+	}
+}
+
+// dashOn returns true if the position in the dash pattern is a dash.
+func dashOn(pos float64, dash []float64) bool {
+	for i, d := range dash {
+		if pos < d {
+			return i%2 == 0
+		}
+		pos -= d
+	}
+	return false
+}
+
+// parseColor converts "#rrggbb" or one of the named colors used
+// for the strokes to a color.
+func parseColor(s string) color.Color {
+	switch strings.ToLower(s) {
+	case "black":
+		return color.Black
+	case "white":
+		return color.White
+	case "grey", "gray":
+		return color.RGBA{R: 128, G: 128, B: 128, A: 255}
+	}
+	if v, err := strconv.ParseUint(strings.TrimPrefix(s, "#"), 16, 32); err == nil && len(s) == 7 {
+		return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 255}
+	}
+	return color.RGBA{R: 255, A: 255}
+}
+
+// pixels converts a width like "1.5px" to a number.
+func pixels(s string) float64 {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(s, "px"), 64)
+	if err != nil {
+		return 1
+	}
+	return v
+}
@@ -0,0 +1,74 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"bytes"
+	"github.com/mdhender/chief/internal/terrain"
+	"image/color"
+	"image/png"
+	"math"
+	"testing"
+)
+
+func TestPNG(t *testing.T) {
+	m := New("AA")
+	m.Observe("AA 0505", &Observation{Turn: "900-01", Terrain: terrain.PR})
+	m.Observe("AA 0506", &Observation{Turn: "900-01", Terrain: terrain.SW, Settlements: []string{"Haven"}})
+	s := NewSVG(false)
+	for _, tile := range m.Tiles() {
+		s.AddTile(tile)
+	}
+
+	for _, tc := range []struct {
+		id    int
+		scale float64
+	}{
+		{1, 1},
+		{2, 2.5},
+	} {
+		var buf bytes.Buffer
+		if err := s.PNG(&buf, tc.scale); err != nil {
+			t.Fatalf("%d: %v\n", tc.id, err)
+		}
+		img, err := png.Decode(&buf)
+		if err != nil {
+			t.Fatalf("%d: decode: %v\n", tc.id, err)
+		}
+
+		// the image is the view box, with the margin, at the scale
+		vb := s.viewBox
+		width := int(math.Ceil(float64(vb.maxX-vb.minX+EDGE) * tc.scale))
+		height := int(math.Ceil(float64(vb.maxY-vb.minY+EDGE) * tc.scale))
+		if b := img.Bounds(); b.Dx() != width || b.Dy() != height {
+			t.Errorf("%d: bounds: expected %d x %d: got %d x %d\n", tc.id, width, height, b.Dx(), b.Dy())
+		}
+
+		// pixel returns the color at the point in the view box
+		pixel := func(x, y float64) color.RGBA {
+			px := int((x - float64(vb.minX-EDGE/2)) * tc.scale)
+			py := int((y - float64(vb.minY-EDGE/2)) * tc.scale)
+			return color.RGBAModel.Convert(img.At(px, py)).(color.RGBA)
+		}
+		for _, poly := range s.polygons {
+			want := color.RGBAModel.Convert(parseColor(poly.style.fill)).(color.RGBA)
+			if poly.settlement != "" {
+				// the settlement marker is white in the middle
+				want = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+			}
+			if got := pixel(poly.cx, poly.cy); got != want {
+				t.Errorf("%d: %s: expected %v: got %v\n", tc.id, poly.tile.Id(), want, got)
+			}
+		}
+		// the margin is white
+		if got := img.At(0, 0); color.RGBAModel.Convert(got) != color.RGBAModel.Convert(color.White) {
+			t.Errorf("%d: margin: expected white: got %v\n", tc.id, got)
+		}
+	}
+
+	// a huge scale is refused instead of using all the memory
+	if err := s.PNG(&bytes.Buffer{}, 1000); err == nil {
+		t.Errorf("scale: expected error: got nil\n")
+	}
+}
@@ -6,13 +6,16 @@ package tiles
 import (
 	"bytes"
 	"fmt"
+	"github.com/mdhender/chief/internal/edge"
 	"github.com/mdhender/chief/internal/terrain"
 )
 
 // polygon is the actual hex on the board
 type polygon struct {
+	tile    *Tile
 	x, y    int
 	terrain terrain.Terrain // terrain type of the hex
+	edges   [6]edge.Edge    // edges of the hex, by direction
 
 	cx, cy, radius float64 // center of the hex
 	points         []point
@@ -25,6 +28,8 @@ type polygon struct {
 
 	addCircle bool
 	text      []string
+
+	settlement string // name of the settlement in the hex
 }
 
 func (p *polygon) Bytes(id string, addCoords bool) []byte {
@@ -0,0 +1,109 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"slices"
+)
+
+// Settlement is the history of the settlement in a hex,
+// built from the observations of the tile.
+type Settlement struct {
+	Hex string `json:"hex"`
+	// Name is the name from the latest report.
+	Name string `json:"name"`
+	// Names is every name the settlement was reported with, oldest first.
+	Names []string `json:"names"`
+	// FirstSeen and LastSeen are the first and last turns it was reported.
+	// They are empty if it was only imported.
+	FirstSeen string `json:"first-seen,omitempty"`
+	LastSeen  string `json:"last-seen,omitempty"`
+	// Reports is every report of the settlement, oldest turn first.
+	Reports []*SettlementReport `json:"reports"`
+}
+
+// SettlementReport is one report of a settlement.
+type SettlementReport struct {
+	Turn   string `json:"turn,omitempty"`
+	Source string `json:"source,omitempty"`
+	Clan   string `json:"clan,omitempty"`
+	Unit   string `json:"unit,omitempty"`
+	// UnitInferred is set when the unit is a guess.
+	UnitInferred bool   `json:"unit-inferred,omitempty"`
+	Name         string `json:"name"`
+}
+
+// Renamed returns true if the settlement was reported with more than one name.
+func (s *Settlement) Renamed() bool {
+	return len(s.Names) > 1
+}
+
+// Settlement returns the settlement in the tile, or nil if no
+// observation reported one.
+func (t *Tile) Settlement() *Settlement {
+	var s *Settlement
+	for _, o := range t.Observations {
+		for _, name := range o.Settlements {
+			if s == nil {
+				s = &Settlement{Hex: t.id}
+			}
+			s.Reports = append(s.Reports, &SettlementReport{Turn: o.Turn, Source: o.Source, Clan: o.Clan, Unit: o.Unit, UnitInferred: o.UnitInferred, Name: name})
+			if !slices.Contains(s.Names, name) {
+				s.Names = append(s.Names, name)
+			}
+			s.Name = name
+			if o.Turn != "" {
+				if s.FirstSeen == "" {
+					s.FirstSeen = o.Turn
+				}
+				s.LastSeen = o.Turn
+			}
+		}
+	}
+	return s
+}
+
+// Settlements returns the settlement registry: every settlement on the map,
+// sorted by hex.
+func (m *Map) Settlements() []*Settlement {
+	var list []*Settlement
+	for _, t := range m.Tiles() {
+		if s := t.Settlement(); s != nil {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// settlementsBytes returns the name of every settlement, below the
+// coordinates of the hex. The overview is too small for the names,
+// so it has a marker instead.
+func (s *SVG) settlementsBytes() []byte {
+	buf := bytes.Buffer{}
+	for _, poly := range s.polygons {
+		if poly.settlement == "" {
+			continue
+		}
+		if buf.Len() == 0 {
+			buf.WriteString(`<g class="settlements">`)
+			buf.WriteByte('\n')
+		}
+		name := html.EscapeString(poly.settlement)
+		title := fmt.Sprintf("<title>%s: %s</title>", poly.tile.Id(), name)
+		if s.overview {
+			buf.WriteString(fmt.Sprintf(`<circle cx="%f" cy="%f" r="%f" fill="white" stroke="black" stroke-width="%f">%s</circle>`, poly.cx, poly.cy, poly.radius/2, poly.radius/6, title))
+		} else {
+			buf.WriteString(fmt.Sprintf(`<text x="%f" y="%f" text-anchor="middle" dominant-baseline="hanging" fill="black" stroke="white" stroke-width="1.5" paint-order="stroke" font-size="5" font-weight="bold">%s%s</text>`, poly.cx, poly.cy+poly.radius/3, name, title))
+		}
+		buf.WriteByte('\n')
+	}
+	if buf.Len() != 0 {
+		buf.WriteString(`</g>`)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
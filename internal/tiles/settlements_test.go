@@ -0,0 +1,70 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"github.com/mdhender/chief/internal/terrain"
+	"slices"
+	"testing"
+)
+
+func TestSettlements(t *testing.T) {
+	m := New("AA")
+	m.Observe("AA 0809", &Observation{Turn: "900-03", Unit: "0138e1", Settlements: []string{"New Haven"}})
+	m.Observe("AA 0809", &Observation{Turn: "900-01", Unit: "0138", Settlements: []string{"Haven"}})
+	m.Observe("AA 0809", &Observation{Turn: "900-02", Unit: "0138", Terrain: terrain.PR})
+	m.Observe("AA 0809", &Observation{Turn: "900-02", Unit: "0138c1", UnitInferred: true, Settlements: []string{"Haven"}})
+	m.Observe("AA 0101", &Observation{Source: "import.wxx", Settlements: []string{"Landing"}})
+	m.Observe("AA 0102", &Observation{Turn: "900-01", Terrain: terrain.PR})
+
+	list := m.Settlements()
+	if len(list) != 2 {
+		t.Fatalf("expected 2 settlements: got %d\n", len(list))
+	}
+
+	for _, tc := range []struct {
+		id        int
+		got       *Settlement
+		hex       string
+		name      string
+		names     []string
+		first     string
+		last      string
+		reporters []string // a guessed unit ends with "?"
+	}{
+		{1, list[0], "AA 0101", "Landing", []string{"Landing"}, "", "", []string{""}},
+		{2, list[1], "AA 0809", "New Haven", []string{"Haven", "New Haven"}, "900-01", "900-03", []string{"0138", "0138c1?", "0138e1"}},
+	} {
+		if tc.got.Hex != tc.hex {
+			t.Errorf("%d: hex: expected %q: got %q\n", tc.id, tc.hex, tc.got.Hex)
+		}
+		if tc.got.Name != tc.name {
+			t.Errorf("%d: name: expected %q: got %q\n", tc.id, tc.name, tc.got.Name)
+		}
+		if !slices.Equal(tc.got.Names, tc.names) {
+			t.Errorf("%d: names: expected %v: got %v\n", tc.id, tc.names, tc.got.Names)
+		}
+		if tc.got.Renamed() != (len(tc.names) > 1) {
+			t.Errorf("%d: renamed: expected %v: got %v\n", tc.id, len(tc.names) > 1, tc.got.Renamed())
+		}
+		if tc.got.FirstSeen != tc.first || tc.got.LastSeen != tc.last {
+			t.Errorf("%d: seen: expected %q to %q: got %q to %q\n", tc.id, tc.first, tc.last, tc.got.FirstSeen, tc.got.LastSeen)
+		}
+		var reporters []string
+		for _, r := range tc.got.Reports {
+			if r.UnitInferred {
+				reporters = append(reporters, r.Unit+"?")
+			} else {
+				reporters = append(reporters, r.Unit)
+			}
+		}
+		if !slices.Equal(reporters, tc.reporters) {
+			t.Errorf("%d: reporters: expected %v: got %v\n", tc.id, tc.reporters, reporters)
+		}
+	}
+
+	if got := m.Lookup("AA 0102").Settlement(); got != nil {
+		t.Errorf("3: expected no settlement: got %+v\n", got)
+	}
+}
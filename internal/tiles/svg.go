@@ -6,6 +6,7 @@ package tiles
 import (
 	"bytes"
 	"fmt"
+	"github.com/mdhender/chief/internal/coords"
 	"github.com/mdhender/chief/internal/terrain"
 	"math"
 )
@@ -23,11 +24,16 @@ type SVG struct {
 	viewBox        viewBox
 	layout         Layout
 	polygons       []*polygon
+	tracks         []*track
+	trackColors    map[string]string // unit to color
+	ageTurn        string            // current turn when shading by age
+	grids          []*gridSheet
+	overview       bool // true for the zoomed out map
 	addCoordinates bool
 }
 
 type SHex struct {
-	cube    Hex
+	cube    coords.Cube
 	terrain terrain.Terrain
 }
 
@@ -49,12 +55,17 @@ func NewSVG(ac bool) *SVG {
 }
 
 func (s *SVG) AddTile(tile *Tile) {
-	x, y := tile.ToXY()
+	x, y := tile.Hex.Global()
 	poly := &polygon{
+		tile:    tile,
 		x:       x,
 		y:       y,
 		radius:  s.height / 2.0,
 		terrain: tile.Terrain,
+		edges:   tile.Edges,
+	}
+	if st := tile.Settlement(); st != nil {
+		poly.settlement = st.Name
 	}
 	h := tile.Hex
 	poly.cx, poly.cy = s.layout.centerPoint(h).Coords()
@@ -69,16 +80,15 @@ func (s *SVG) AddTile(tile *Tile) {
 	}
 	poly.style.strokeWidth = "2px"
 	poly.style.strokeWidth = "1px"
+	if s.overview {
+		// the outlines would hide hexes this small
+		poly.style.stroke, poly.style.strokeWidth = poly.style.fill, "0"
+	}
 
 	for _, p := range s.layout.polygonCorners(h) {
 		px, py := p.Coords()
 		poly.points = append(poly.points, point{x: px, y: py})
-		if int(px) > s.viewBox.width {
-			s.viewBox.width = int(px)
-		}
-		if int(py) > s.viewBox.height {
-			s.viewBox.height = int(py)
-		}
+		s.grow(px, py)
 	}
 
 	s.polygons = append(s.polygons, poly)
@@ -87,12 +97,23 @@ func (s *SVG) AddTile(tile *Tile) {
 func (s *SVG) Bytes() []byte {
 	buf := bytes.Buffer{}
 
+	// the legend goes below the map
+	sides := s.sides()
+	kinds := legendKinds(sides)
+	vb := s.viewBox
+	rows := len(kinds)
+	if s.ageTurn != "" {
+		rows += len(ageBands)
+	}
+	if rows != 0 {
+		vb.maxY += EDGE + rows*legendHeight
+	}
+
 	buf.WriteString("<svg")
 	if s.id != "" {
 		buf.WriteString(fmt.Sprintf(" id=%q", s.id))
 	}
-	//buf.WriteString(fmt.Sprintf(` width="%dpx" height="%dpx"`, s.viewBox.width, s.viewBox.height))
-	buf.Write(s.viewBox.Bytes())
+	buf.Write(vb.Bytes())
 	buf.Write([]byte(` xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink">`))
 	buf.WriteByte('\n')
 
@@ -144,16 +165,43 @@ func (s *SVG) Bytes() []byte {
 		}
 	}
 
+	// edges are drawn over the hexes so that neighbors don't hide them
+	buf.WriteByte('\n')
+	buf.Write(s.agesBytes())
+	buf.Write(edgesBytes(sides))
+	buf.Write(s.settlementsBytes())
+	buf.Write(s.gridsBytes())
+	buf.Write(s.tracksBytes())
+	if len(kinds) != 0 {
+		buf.Write(legendBytes(kinds, float64(s.viewBox.minX), float64(s.viewBox.maxY+EDGE)))
+	}
+	if s.ageTurn != "" {
+		buf.Write(ageLegendBytes(float64(s.viewBox.minX), float64(s.viewBox.maxY+EDGE+len(kinds)*legendHeight)))
+	}
+
 	buf.Write([]byte("</svg>"))
 
 	return buf.Bytes()
 }
 
+// grow extends the view box to include the point.
+func (s *SVG) grow(px, py float64) {
+	if !s.viewBox.set {
+		s.viewBox.minX, s.viewBox.minY = int(px), int(py)
+		s.viewBox.maxX, s.viewBox.maxY = int(px), int(py)
+		s.viewBox.set = true
+	}
+	s.viewBox.minX, s.viewBox.minY = min(s.viewBox.minX, int(math.Floor(px))), min(s.viewBox.minY, int(math.Floor(py)))
+	s.viewBox.maxX, s.viewBox.maxY = max(s.viewBox.maxX, int(math.Ceil(px))), max(s.viewBox.maxY, int(math.Ceil(py)))
+}
+
+// viewBox is the bounds of the polygons, plus a margin when written.
 type viewBox struct {
-	minX, minY    int
-	width, height int
+	minX, minY int
+	maxX, maxY int
+	set        bool // false until the first point is added
 }
 
 func (v viewBox) Bytes() []byte {
-	return []byte(fmt.Sprintf(` viewBox="%d %d %d %d"`, v.minX, v.minY, v.width+EDGE/2, v.height+EDGE/2))
+	return []byte(fmt.Sprintf(` viewBox="%d %d %d %d"`, v.minX-EDGE/2, v.minY-EDGE/2, v.maxX-v.minX+EDGE, v.maxY-v.minY+EDGE))
 }
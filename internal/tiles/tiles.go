@@ -4,16 +4,23 @@
 package tiles
 
 import (
+	"github.com/mdhender/chief/internal/coords"
 	"github.com/mdhender/chief/internal/edge"
 	"github.com/mdhender/chief/internal/terrain"
 )
 
 type Tile struct {
-	Hex
+	// Hex is the location of the tile on the map
+	Hex     coords.Cube
 	Terrain terrain.Terrain
 	// N, NE, SE, S, SW, NW
 	Edges [6]edge.Edge
-	id    string
+	// Inferred is set for the edges that weren't observed in this hex,
+	// but were reported from the neighbor on the other side.
+	Inferred [6]bool
+	// Observations is every report of the hex, oldest turn first
+	Observations []*Observation
+	id           string
 }
 
 // Id is the unique identifier for the Tile.
@@ -0,0 +1,144 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/mdhender/chief/internal/coords"
+	"html"
+)
+
+// Track is the path a unit or one of its scouts took during a turn.
+type Track struct {
+	Turn string
+	Unit string
+	// Scout is the id of the scout, or empty for the unit itself.
+	Scout string
+	// Hexes is every hex the unit was in, starting hex first.
+	Hexes []string
+	// Stops are the moves that failed.
+	Stops []Stop
+}
+
+// Stop is a failed move out of one of the hexes in the track.
+type Stop struct {
+	Hex       int // index into the track's hexes
+	Direction Direction
+}
+
+// Name returns the label for the track, like "0138e1" or "0138e1 s2".
+func (t *Track) Name() string {
+	if t.Scout == "" {
+		return t.Unit
+	}
+	return fmt.Sprintf("%s s%s", t.Unit, t.Scout)
+}
+
+// trackColors is the palette for the tracks. Each unit gets the next color,
+// and its scouts use the same color.
+var trackColors = []string{
+	"#e6194b", "#4363d8", "#f58231", "#911eb4", "#008080",
+	"#f032e6", "#9a6324", "#800000", "#000075", "#3cb44b",
+}
+
+// track is a Track on the screen.
+type track struct {
+	name, turn string
+	color      string
+	dashed     bool
+	points     []point
+	stops      []point
+}
+
+// AddTrack adds the path of a unit to the map. Tracks are drawn over the
+// hexes and edges: a line through the centers of the hexes, dashed for
+// scouts, with a marker where a move failed and labels at the start and
+// end of the path.
+func (s *SVG) AddTrack(t *Track) {
+	if len(t.Hexes) == 0 {
+		return
+	}
+	if s.trackColors == nil {
+		s.trackColors = map[string]string{}
+	}
+	color, ok := s.trackColors[t.Unit]
+	if !ok {
+		color = trackColors[len(s.trackColors)%len(trackColors)]
+		s.trackColors[t.Unit] = color
+	}
+
+	tr := &track{name: t.Name(), turn: t.Turn, color: color, dashed: t.Scout != ""}
+	var hexes []coords.Cube
+	for _, gxy := range t.Hexes {
+		h := hexOf(gxy)
+		hexes = append(hexes, h)
+		c := s.layout.centerPoint(h)
+		tr.points = append(tr.points, c)
+		s.grow(c.x, c.y)
+	}
+	for _, stop := range t.Stops {
+		if stop.Hex < 0 || stop.Hex >= len(hexes) {
+			continue
+		}
+		// the marker goes in the middle of the side the unit couldn't cross
+		corners := s.layout.polygonCorners(hexes[stop.Hex])
+		from, to := corners[sideCorners[stop.Direction][0]], corners[sideCorners[stop.Direction][1]]
+		tr.stops = append(tr.stops, point{x: (from.x + to.x) / 2, y: (from.y + to.y) / 2})
+	}
+	s.tracks = append(s.tracks, tr)
+}
+
+// tracksBytes returns the tracks, their markers, and their labels.
+func (s *SVG) tracksBytes() []byte {
+	if len(s.tracks) == 0 {
+		return nil
+	}
+	buf := bytes.Buffer{}
+	buf.WriteString(`<g class="tracks" fill="none">`)
+	buf.WriteByte('\n')
+	for _, tr := range s.tracks {
+		title := fmt.Sprintf("<title>%s %s</title>", html.EscapeString(tr.name), tr.turn)
+		if len(tr.points) > 1 {
+			buf.WriteString(`<polyline points="`)
+			for i, p := range tr.points {
+				if i > 0 {
+					buf.WriteByte(' ')
+				}
+				buf.Write(p.Bytes())
+			}
+			buf.WriteString(fmt.Sprintf(`" stroke="%s" stroke-width="1.5" stroke-linejoin="round" stroke-opacity="0.8"`, tr.color))
+			if tr.dashed {
+				buf.WriteString(` stroke-dasharray="3,2"`)
+			}
+			buf.WriteString(">" + title + "</polyline>\n")
+		}
+
+		// hollow marker at the start, solid at the end
+		start, end := tr.points[0], tr.points[len(tr.points)-1]
+		buf.WriteString(fmt.Sprintf(`<circle cx="%f" cy="%f" r="2" fill="white" stroke="%s">%s</circle>`, start.x, start.y, tr.color, title))
+		buf.WriteByte('\n')
+		if len(tr.points) > 1 {
+			buf.WriteString(fmt.Sprintf(`<circle cx="%f" cy="%f" r="2" fill="%s">%s</circle>`, end.x, end.y, tr.color, title))
+			buf.WriteByte('\n')
+		}
+
+		for _, p := range tr.stops {
+			buf.WriteString(fmt.Sprintf(`<circle class="stop" cx="%f" cy="%f" r="2.5" fill="#cc0000" stroke="white" stroke-width="0.5"><title>%s %s: move failed</title></circle>`, p.x, p.y, html.EscapeString(tr.name), tr.turn))
+			buf.WriteString(fmt.Sprintf(`<line x1="%f" y1="%f" x2="%f" y2="%f" stroke="white" stroke-width="1" />`, p.x-1.5, p.y, p.x+1.5, p.y))
+			buf.WriteByte('\n')
+		}
+
+		label := html.EscapeString(tr.name)
+		buf.WriteString(fmt.Sprintf(`<text x="%f" y="%f" fill="%s" font-size="5" text-anchor="middle">%s %s</text>`, start.x, start.y-3, tr.color, label, tr.turn))
+		buf.WriteByte('\n')
+		if len(tr.points) > 1 {
+			buf.WriteString(fmt.Sprintf(`<text x="%f" y="%f" fill="%s" font-size="5" text-anchor="middle">%s end</text>`, end.x, end.y+6, tr.color, label))
+			buf.WriteByte('\n')
+		}
+	}
+	buf.WriteString(`</g>`)
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
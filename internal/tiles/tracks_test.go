@@ -0,0 +1,44 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package tiles
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddTrack(t *testing.T) {
+	// the stop marker is on the side between the hex and the one the unit couldn't enter
+	for _, id := range []string{"AA 0505", "AA 0605"} {
+		for d := N; d <= NW; d++ {
+			m := New("AA")
+			from := m.MakeTile(id)
+			to := m.Neighbor(from, d)
+
+			s := NewSVG(false)
+			s.AddTrack(&Track{Turn: "900-01", Unit: "0138", Hexes: []string{id}, Stops: []Stop{{Hex: 0, Direction: d}}})
+			if len(s.tracks) != 1 || len(s.tracks[0].stops) != 1 {
+				t.Errorf("%s %s: want 1 track with 1 stop\n", id, d)
+				continue
+			}
+			a, b, p := s.layout.centerPoint(from.Hex), s.layout.centerPoint(to.Hex), s.tracks[0].stops[0]
+			if math.Abs((a.x+b.x)/2-p.x) > 0.01 || math.Abs((a.y+b.y)/2-p.y) > 0.01 {
+				t.Errorf("%s %s: stop is not between %s and %s\n", id, d, from.Id(), to.Id())
+			}
+		}
+	}
+
+	// scouts use the color of their unit
+	s := NewSVG(false)
+	s.AddTrack(&Track{Unit: "0138", Hexes: []string{"AA 0101"}})
+	s.AddTrack(&Track{Unit: "0138e1", Hexes: []string{"AA 0101"}})
+	s.AddTrack(&Track{Unit: "0138", Scout: "1", Hexes: []string{"AA 0101"}})
+	if s.tracks[0].color == s.tracks[1].color {
+		t.Errorf("units: want different colors, got %s\n", s.tracks[0].color)
+	} else if s.tracks[0].color != s.tracks[2].color {
+		t.Errorf("scout: want %s, got %s\n", s.tracks[0].color, s.tracks[2].color)
+	} else if !s.tracks[2].dashed {
+		t.Errorf("scout: want dashed\n")
+	}
+}
@@ -0,0 +1,36 @@
+// chief - a TribeNet player aid
+// Copyright (c) 2023 Michael D Henderson. All rights reserved.
+
+package turnrpt
+
+import (
+	"github.com/mdhender/chief/internal/golden"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGolden(t *testing.T) {
+	testdata := filepath.Join("..", "..", "testdata")
+	for _, path := range golden.Corpus(t, filepath.Join(testdata, "turn-reports")) {
+		name := golden.Name(path)
+		t.Run(name, func(t *testing.T) {
+			input, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sections, err := Parse(input, nil)
+			var msg string
+			if err != nil {
+				msg = err.Error()
+			}
+			golden.Compare(t, filepath.Join(testdata, "golden", "turnrpt", name+".json"), struct {
+				Sections []*TribeSection `json:"sections"`
+				Error    string          `json:"error,omitempty"`
+			}{
+				Sections: sections,
+				Error:    msg,
+			})
+		})
+	}
+}
@@ -356,7 +356,7 @@ func parseTerrain(input []byte) (terrain.Terrain, []byte, error) {
 	} else if bytes.Equal(run, []byte("DH")) {
 		return terrain.DH, rest, nil
 	} else if bytes.Equal(run, []byte("Fords")) {
-		return terrain.Fords, rest, nil
+		return terrain.FORDS, rest, nil
 	} else if bytes.Equal(run, []byte("GH")) {
 		return terrain.GH, rest, nil
 	} else if bytes.Equal(run, []byte("HSM")) {